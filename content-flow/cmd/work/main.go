@@ -1,17 +1,32 @@
 package main
 
 import (
+	"flag"
 	"log"
 	"os"
 	"os/signal"
 
+	"github.com/zerokkcoder/content-flow/internal/conf"
 	"github.com/zerokkcoder/content-flow/internal/process"
 	"gorm.io/driver/mysql"
 	"gorm.io/gorm"
 )
 
+// flagconf is the config flag.
+var flagconf string
+
+func init() {
+	flag.StringVar(&flagconf, "conf", "../../configs/config.yaml", "config path, eg: -conf config.yaml")
+}
+
 func main() {
-	mysqlDB, err := gorm.Open(mysql.Open("root:@tcp(localhost:3306)/?charset=utf8mb4&parseTime=True&loc=Local"), &gorm.Config{})
+	flag.Parse()
+	c, err := conf.Load(flagconf)
+	if err != nil {
+		panic(err)
+	}
+
+	mysqlDB, err := gorm.Open(mysql.Open(c.Database.Source), &gorm.Config{})
 	if err != nil {
 		panic(err)
 	}
@@ -19,10 +34,10 @@ func main() {
 	if err != nil {
 		panic(err)
 	}
-	db.SetMaxOpenConns(4)
-	db.SetMaxIdleConns(2)
+	db.SetMaxOpenConns(c.Database.MaxOpenConns)
+	db.SetMaxIdleConns(c.Database.MaxIdleConns)
 
-	process.ExecContentWork(mysqlDB)
+	process.ExecContentWork(c, mysqlDB)
 
 	// 监听操作系统的退出信号
 	quit := make(chan os.Signal, 1)
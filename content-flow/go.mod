@@ -5,6 +5,7 @@ go 1.22.4
 require (
 	github.com/s8sg/goflow v0.1.5-0.20230729173817-d3eded44d6f8
 	github.com/zerokkcoder/content-system v0.0.0-20240618122708-3fc8c875ec94
+	gopkg.in/yaml.v3 v3.0.1
 	gorm.io/driver/mysql v1.5.7
 	gorm.io/gorm v1.25.10
 )
@@ -54,5 +55,4 @@ require (
 	golang.org/x/text v0.16.0 // indirect
 	google.golang.org/protobuf v1.34.2 // indirect
 	gopkg.in/redis.v5 v5.2.9 // indirect
-	gopkg.in/yaml.v3 v3.0.1 // indirect
 )
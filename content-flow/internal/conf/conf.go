@@ -0,0 +1,49 @@
+package conf
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Config content-flow 的配置
+type Config struct {
+	Database      Database      `yaml:"database"`
+	Flow          Flow          `yaml:"flow"`
+	ContentManage ContentManage `yaml:"content_manage"`
+}
+
+type Database struct {
+	Source       string `yaml:"source"`
+	MaxOpenConns int    `yaml:"max_open_conns"`
+	MaxIdleConns int    `yaml:"max_idle_conns"`
+}
+
+type Flow struct {
+	Port     int    `yaml:"port"`
+	RedisURL string `yaml:"redis_url"`
+}
+
+type ContentManage struct {
+	// HTTP 地址, 如 http://localhost:8000
+	Addr    string        `yaml:"addr"`
+	Timeout time.Duration `yaml:"timeout"`
+}
+
+// Load 读取 yaml 配置文件
+func Load(path string) (*Config, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var c Config
+	if err := yaml.Unmarshal(b, &c); err != nil {
+		return nil, fmt.Errorf("parse %s: %w", path, err)
+	}
+	if c.ContentManage.Addr == "" {
+		return nil, fmt.Errorf("%s: content_manage.addr is required", path)
+	}
+	return &c, nil
+}
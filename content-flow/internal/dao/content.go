@@ -2,50 +2,30 @@ package dao
 
 import (
 	"fmt"
-	"hash/fnv"
 	"log"
-	"math/big"
 
+	"github.com/zerokkcoder/content-flow/internal/conf"
 	"github.com/zerokkcoder/content-flow/internal/model"
 	"gorm.io/gorm"
 )
 
-const contentNumTables = 4
-
-func getContentDetailTable(contentID string) string {
-	tableIndex := getContentTableIndex(contentID)
-	table := fmt.Sprintf("cms_content.t_content_details_%d", tableIndex)
-	return table
-}
-
-func getContentTableIndex(uuid string) int {
-	hash := fnv.New64()
-	hash.Write([]byte(uuid))
-	hashValue := hash.Sum64()
-	fmt.Println("hashValue = ", hashValue)
-
-	bigNum := big.NewInt(int64(hashValue))
-	mod := big.NewInt(contentNumTables)
-	tableIndex := bigNum.Mod(bigNum, mod).Int64()
-	return int(tableIndex)
-}
-
 type ContentDao struct {
-	db *gorm.DB
+	db     *gorm.DB
+	manage *manageClient
 }
 
-func NewContentDao(db *gorm.DB) *ContentDao {
-	return &ContentDao{db: db}
+func NewContentDao(db *gorm.DB, c conf.ContentManage) *ContentDao {
+	return &ContentDao{db: db, manage: newManageClient(c)}
 }
 
+// First 通过 content-manage 读取内容, 详情所在的分片表和分片库由 content-manage 决定
 func (c *ContentDao) First(contentID string) (*model.ContentDetail, error) {
-	var detail model.ContentDetail
-	if err := c.db.Table(getContentDetailTable(contentID)).
-		Where("content_id = ?", contentID).First(&detail).Error; err != nil {
+	detail, err := c.manage.get(contentID)
+	if err != nil {
 		fmt.Printf("ContentDao First error = %v\n", err)
 		return nil, err
 	}
-	return &detail, nil
+	return detail.toModel(), nil
 }
 
 func (c *ContentDao) Create(detail *model.ContentDetail) (int64, error) {
@@ -131,10 +111,13 @@ func (c *ContentDao) Find(params *FindParams) ([]*model.ContentDetail, int64, er
 	return data, total, nil
 }
 
+// UpdateByID 通过 content-manage 更新内容的一个字段, 由 content-manage 同步索引表、递增版本、记录历史版本并发布变更事件
 func (c *ContentDao) UpdateByID(contentID string, column string, value interface{}) error {
-	if err := c.db.Table(getContentDetailTable(contentID)).
-		Where("content_id = ?", contentID).
-		Update(column, value).Error; err != nil {
+	detail, err := c.manage.get(contentID)
+	if err == nil {
+		err = c.manage.update(detail.ID, column, value)
+	}
+	if err != nil {
 		log.Printf("ContentDao UpdateByID error = %v\n", err)
 		return err
 	}
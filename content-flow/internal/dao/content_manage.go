@@ -0,0 +1,146 @@
+package dao
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/zerokkcoder/content-flow/internal/conf"
+	"github.com/zerokkcoder/content-flow/internal/model"
+)
+
+// flowEditor 加工流程修改内容时记录在历史版本中的修改人
+const flowEditor = "content-flow"
+
+const defaultTimeout = time.Second
+
+// manageClient 通过 content-manage 的 HTTP 接口读写内容, 重分片双写、分片库、缓存失效和变更事件都由 content-manage 处理
+type manageClient struct {
+	addr    string
+	timeout time.Duration
+	client  *http.Client
+}
+
+func newManageClient(c conf.ContentManage) *manageClient {
+	timeout := c.Timeout
+	if timeout <= 0 {
+		timeout = defaultTimeout
+	}
+	return &manageClient{addr: strings.TrimSuffix(c.Addr, "/"), timeout: timeout, client: http.DefaultClient}
+}
+
+// content content-manage 返回的内容, int64 字段按 protojson 编码为字符串
+type content struct {
+	ID             int64  `json:"id,string"`
+	ContentID      string `json:"contentId"`
+	Title          string `json:"title"`
+	VideoURL       string `json:"videoUrl"`
+	Author         string `json:"author"`
+	Description    string `json:"description"`
+	Thumbnail      string `json:"thumbnail"`
+	Category       string `json:"category"`
+	Resolution     string `json:"resolution"`
+	FileSize       int64  `json:"fileSize,string"`
+	Format         string `json:"format"`
+	Quality        int    `json:"quality"`
+	ApprovalStatus int    `json:"approvalStatus"`
+}
+
+func (c *content) toModel() *model.ContentDetail {
+	return &model.ContentDetail{
+		ID:             c.ID,
+		ContentID:      c.ContentID,
+		Title:          c.Title,
+		VideoURL:       c.VideoURL,
+		Author:         c.Author,
+		Description:    c.Description,
+		Thumbnail:      c.Thumbnail,
+		Category:       c.Category,
+		Resolution:     c.Resolution,
+		FileSize:       c.FileSize,
+		Format:         c.Format,
+		Quality:        c.Quality,
+		ApprovalStatus: c.ApprovalStatus,
+	}
+}
+
+// get 按 content_id 读取内容
+func (m *manageClient) get(contentID string) (*content, error) {
+	var rsp struct {
+		Content *content `json:"content"`
+	}
+	if err := m.do(http.MethodGet, "/v1/contents/uuid/"+url.PathEscape(contentID), nil, &rsp); err != nil {
+		return nil, err
+	}
+	if rsp.Content == nil {
+		return nil, fmt.Errorf("content %s not found", contentID)
+	}
+	return rsp.Content, nil
+}
+
+// update 只更新 id 的 column 字段
+func (m *manageClient) update(id int64, column string, value interface{}) error {
+	req := map[string]interface{}{
+		"content": map[string]interface{}{
+			"id":   id,
+			column: value,
+		},
+		"editor": flowEditor,
+		// FieldMask 的 json 格式使用 lowerCamelCase 路径
+		"update_mask": camelCase(column),
+	}
+	return m.do(http.MethodPatch, fmt.Sprintf("/v1/contents/%d", id), req, nil)
+}
+
+func (m *manageClient) do(method, path string, in, out interface{}) error {
+	ctx, cancel := context.WithTimeout(context.Background(), m.timeout)
+	defer cancel()
+	var body io.Reader
+	if in != nil {
+		b, err := json.Marshal(in)
+		if err != nil {
+			return err
+		}
+		body = bytes.NewReader(b)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, m.addr+path, body)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	rsp, err := m.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer rsp.Body.Close()
+	if rsp.StatusCode != http.StatusOK {
+		// kratos 的错误格式
+		var e struct {
+			Reason  string `json:"reason"`
+			Message string `json:"message"`
+		}
+		_ = json.NewDecoder(rsp.Body).Decode(&e)
+		return fmt.Errorf("%s %s: status %d, reason = %s, message = %s", method, path, rsp.StatusCode, e.Reason, e.Message)
+	}
+	if out == nil {
+		return nil
+	}
+	return json.NewDecoder(rsp.Body).Decode(out)
+}
+
+// camelCase 把 approval_status 转换为 approvalStatus
+func camelCase(s string) string {
+	parts := strings.Split(s, "_")
+	for i := 1; i < len(parts); i++ {
+		if parts[i] != "" {
+			parts[i] = strings.ToUpper(parts[i][:1]) + parts[i][1:]
+		}
+	}
+	return strings.Join(parts, "")
+}
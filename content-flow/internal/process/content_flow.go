@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 
+	"github.com/zerokkcoder/content-flow/internal/conf"
 	"github.com/zerokkcoder/content-flow/internal/dao"
 	"gorm.io/gorm"
 
@@ -11,13 +12,13 @@ import (
 	goflow "github.com/s8sg/goflow/v1"
 )
 
-func ExecContentFlow(db *gorm.DB) {
+func ExecContentFlow(c *conf.Config, db *gorm.DB) {
 	contentFlow := &ContentFlow{
-		contentDao: dao.NewContentDao(db),
+		contentDao: dao.NewContentDao(db, c.ContentManage),
 	}
 	fs := goflow.FlowService{
-		Port:              7788,
-		RedisURL:          "localhost:6379",
+		Port:              c.Flow.Port,
+		RedisURL:          c.Flow.RedisURL,
 		WorkerConcurrency: 1,
 	}
 	_ = fs.Register("content-flow", contentFlow.flowHandle)
@@ -27,13 +28,13 @@ func ExecContentFlow(db *gorm.DB) {
 	}
 }
 
-func ExecContentWork(db *gorm.DB) {
+func ExecContentWork(c *conf.Config, db *gorm.DB) {
 	contentFlow := &ContentFlow{
-		contentDao: dao.NewContentDao(db),
+		contentDao: dao.NewContentDao(db, c.ContentManage),
 	}
 	fs := goflow.FlowService{
-		Port:              7788,
-		RedisURL:          "localhost:6379",
+		Port:              c.Flow.Port,
+		RedisURL:          c.Flow.RedisURL,
 		WorkerConcurrency: 4,
 	}
 	_ = fs.Register("content-flow", contentFlow.flowHandle)
@@ -0,0 +1,5398 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: api/operate/app.proto
+
+package operate
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on Content with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Content) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Content with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in ContentMultiError, or nil if none found.
+func (m *Content) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Content) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if utf8.RuneCountInString(m.GetTitle()) > 255 {
+		err := ContentValidationError{
+			field:  "Title",
+			reason: "value length must be at most 255 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetVideoUrl() != "" {
+
+		if utf8.RuneCountInString(m.GetVideoUrl()) > 255 {
+			err := ContentValidationError{
+				field:  "VideoUrl",
+				reason: "value length must be at most 255 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if uri, err := url.Parse(m.GetVideoUrl()); err != nil {
+			err = ContentValidationError{
+				field:  "VideoUrl",
+				reason: "value must be a valid URI",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else if !uri.IsAbs() {
+			err := ContentValidationError{
+				field:  "VideoUrl",
+				reason: "value must be absolute",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	// no validation rules for Author
+
+	// no validation rules for Description
+
+	// no validation rules for Thumbnail
+
+	// no validation rules for Category
+
+	if d := m.GetDuration(); d != nil {
+		dur, err := d.AsDuration(), d.CheckValid()
+		if err != nil {
+			err = ContentValidationError{
+				field:  "Duration",
+				reason: "value is not a valid duration",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+
+			gte := time.Duration(0*time.Second + 0*time.Nanosecond)
+
+			if dur < gte {
+				err := ContentValidationError{
+					field:  "Duration",
+					reason: "value must be greater than or equal to 0s",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+		}
+	}
+
+	// no validation rules for Resolution
+
+	if m.GetFileSize() < 0 {
+		err := ContentValidationError{
+			field:  "FileSize",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Format
+
+	if val := m.GetQuality(); val < 0 || val > 3 {
+		err := ContentValidationError{
+			field:  "Quality",
+			reason: "value must be inside range [0, 3]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetApprovalStatus(); val < 0 || val > 3 {
+		err := ContentValidationError{
+			field:  "ApprovalStatus",
+			reason: "value must be inside range [0, 3]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetDeletedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ContentValidationError{
+					field:  "DeletedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ContentValidationError{
+					field:  "DeletedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDeletedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ContentValidationError{
+				field:  "DeletedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Version
+
+	// no validation rules for ContentId
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ContentValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ContentValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ContentValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ContentValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ContentValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ContentValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ContentMultiError(errors)
+	}
+
+	return nil
+}
+
+// ContentMultiError is an error wrapping multiple validation errors returned
+// by Content.ValidateAll() if the designated constraints aren't met.
+type ContentMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ContentMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ContentMultiError) AllErrors() []error { return m }
+
+// ContentValidationError is the validation error returned by Content.Validate
+// if the designated constraints aren't met.
+type ContentValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ContentValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ContentValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ContentValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ContentValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ContentValidationError) ErrorName() string { return "ContentValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ContentValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sContent.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ContentValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ContentValidationError{}
+
+// Validate checks the field values on CreateContentReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *CreateContentReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateContentReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateContentReqMultiError, or nil if none found.
+func (m *CreateContentReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateContentReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetContent() == nil {
+		err := CreateContentReqValidationError{
+			field:  "Content",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetContent()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateContentReqValidationError{
+					field:  "Content",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateContentReqValidationError{
+					field:  "Content",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetContent()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateContentReqValidationError{
+				field:  "Content",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if utf8.RuneCountInString(m.GetIdempotencyKey()) > 128 {
+		err := CreateContentReqValidationError{
+			field:  "IdempotencyKey",
+			reason: "value length must be at most 128 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CreateContentReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateContentReqMultiError is an error wrapping multiple validation errors
+// returned by CreateContentReq.ValidateAll() if the designated constraints
+// aren't met.
+type CreateContentReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateContentReqMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateContentReqMultiError) AllErrors() []error { return m }
+
+// CreateContentReqValidationError is the validation error returned by
+// CreateContentReq.Validate if the designated constraints aren't met.
+type CreateContentReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateContentReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateContentReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateContentReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateContentReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateContentReqValidationError) ErrorName() string { return "CreateContentReqValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CreateContentReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateContentReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateContentReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateContentReqValidationError{}
+
+// Validate checks the field values on CreateContentRsp with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *CreateContentRsp) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateContentRsp with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateContentRspMultiError, or nil if none found.
+func (m *CreateContentRsp) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateContentRsp) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetContent()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateContentRspValidationError{
+					field:  "Content",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateContentRspValidationError{
+					field:  "Content",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetContent()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateContentRspValidationError{
+				field:  "Content",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateContentRspMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateContentRspMultiError is an error wrapping multiple validation errors
+// returned by CreateContentRsp.ValidateAll() if the designated constraints
+// aren't met.
+type CreateContentRspMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateContentRspMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateContentRspMultiError) AllErrors() []error { return m }
+
+// CreateContentRspValidationError is the validation error returned by
+// CreateContentRsp.Validate if the designated constraints aren't met.
+type CreateContentRspValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateContentRspValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateContentRspValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateContentRspValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateContentRspValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateContentRspValidationError) ErrorName() string { return "CreateContentRspValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CreateContentRspValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateContentRsp.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateContentRspValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateContentRspValidationError{}
+
+// Validate checks the field values on UpdateContentReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *UpdateContentReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateContentReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateContentReqMultiError, or nil if none found.
+func (m *UpdateContentReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateContentReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetContent() == nil {
+		err := UpdateContentReqValidationError{
+			field:  "Content",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetContent()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateContentReqValidationError{
+					field:  "Content",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateContentReqValidationError{
+					field:  "Content",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetContent()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateContentReqValidationError{
+				field:  "Content",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Editor
+
+	if all {
+		switch v := interface{}(m.GetUpdateMask()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateContentReqValidationError{
+					field:  "UpdateMask",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateContentReqValidationError{
+					field:  "UpdateMask",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdateMask()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateContentReqValidationError{
+				field:  "UpdateMask",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for ExpectedVersion
+
+	if len(errors) > 0 {
+		return UpdateContentReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateContentReqMultiError is an error wrapping multiple validation errors
+// returned by UpdateContentReq.ValidateAll() if the designated constraints
+// aren't met.
+type UpdateContentReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateContentReqMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateContentReqMultiError) AllErrors() []error { return m }
+
+// UpdateContentReqValidationError is the validation error returned by
+// UpdateContentReq.Validate if the designated constraints aren't met.
+type UpdateContentReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateContentReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateContentReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateContentReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateContentReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateContentReqValidationError) ErrorName() string { return "UpdateContentReqValidationError" }
+
+// Error satisfies the builtin error interface
+func (e UpdateContentReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateContentReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateContentReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateContentReqValidationError{}
+
+// Validate checks the field values on UpdateContentRsp with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *UpdateContentRsp) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateContentRsp with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateContentRspMultiError, or nil if none found.
+func (m *UpdateContentRsp) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateContentRsp) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetContent()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateContentRspValidationError{
+					field:  "Content",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateContentRspValidationError{
+					field:  "Content",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetContent()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateContentRspValidationError{
+				field:  "Content",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateContentRspMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateContentRspMultiError is an error wrapping multiple validation errors
+// returned by UpdateContentRsp.ValidateAll() if the designated constraints
+// aren't met.
+type UpdateContentRspMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateContentRspMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateContentRspMultiError) AllErrors() []error { return m }
+
+// UpdateContentRspValidationError is the validation error returned by
+// UpdateContentRsp.Validate if the designated constraints aren't met.
+type UpdateContentRspValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateContentRspValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateContentRspValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateContentRspValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateContentRspValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateContentRspValidationError) ErrorName() string { return "UpdateContentRspValidationError" }
+
+// Error satisfies the builtin error interface
+func (e UpdateContentRspValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateContentRsp.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateContentRspValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateContentRspValidationError{}
+
+// Validate checks the field values on DeleteContentReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *DeleteContentReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteContentReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteContentReqMultiError, or nil if none found.
+func (m *DeleteContentReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteContentReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for ExpectedVersion
+
+	if len(errors) > 0 {
+		return DeleteContentReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteContentReqMultiError is an error wrapping multiple validation errors
+// returned by DeleteContentReq.ValidateAll() if the designated constraints
+// aren't met.
+type DeleteContentReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteContentReqMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteContentReqMultiError) AllErrors() []error { return m }
+
+// DeleteContentReqValidationError is the validation error returned by
+// DeleteContentReq.Validate if the designated constraints aren't met.
+type DeleteContentReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteContentReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteContentReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteContentReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteContentReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteContentReqValidationError) ErrorName() string { return "DeleteContentReqValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DeleteContentReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteContentReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteContentReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteContentReqValidationError{}
+
+// Validate checks the field values on DeleteContentRsp with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *DeleteContentRsp) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteContentRsp with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteContentRspMultiError, or nil if none found.
+func (m *DeleteContentRsp) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteContentRsp) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return DeleteContentRspMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteContentRspMultiError is an error wrapping multiple validation errors
+// returned by DeleteContentRsp.ValidateAll() if the designated constraints
+// aren't met.
+type DeleteContentRspMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteContentRspMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteContentRspMultiError) AllErrors() []error { return m }
+
+// DeleteContentRspValidationError is the validation error returned by
+// DeleteContentRsp.Validate if the designated constraints aren't met.
+type DeleteContentRspValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteContentRspValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteContentRspValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteContentRspValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteContentRspValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteContentRspValidationError) ErrorName() string { return "DeleteContentRspValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DeleteContentRspValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteContentRsp.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteContentRspValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteContentRspValidationError{}
+
+// Validate checks the field values on GetContentReq with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GetContentReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetContentReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GetContentReqMultiError, or
+// nil if none found.
+func (m *GetContentReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetContentReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for ContentId
+
+	if len(errors) > 0 {
+		return GetContentReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetContentReqMultiError is an error wrapping multiple validation errors
+// returned by GetContentReq.ValidateAll() if the designated constraints
+// aren't met.
+type GetContentReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetContentReqMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetContentReqMultiError) AllErrors() []error { return m }
+
+// GetContentReqValidationError is the validation error returned by
+// GetContentReq.Validate if the designated constraints aren't met.
+type GetContentReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetContentReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetContentReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetContentReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetContentReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetContentReqValidationError) ErrorName() string { return "GetContentReqValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetContentReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetContentReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetContentReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetContentReqValidationError{}
+
+// Validate checks the field values on GetContentRsp with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GetContentRsp) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetContentRsp with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GetContentRspMultiError, or
+// nil if none found.
+func (m *GetContentRsp) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetContentRsp) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetContent()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetContentRspValidationError{
+					field:  "Content",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetContentRspValidationError{
+					field:  "Content",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetContent()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetContentRspValidationError{
+				field:  "Content",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetContentRspMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetContentRspMultiError is an error wrapping multiple validation errors
+// returned by GetContentRsp.ValidateAll() if the designated constraints
+// aren't met.
+type GetContentRspMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetContentRspMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetContentRspMultiError) AllErrors() []error { return m }
+
+// GetContentRspValidationError is the validation error returned by
+// GetContentRsp.Validate if the designated constraints aren't met.
+type GetContentRspValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetContentRspValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetContentRspValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetContentRspValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetContentRspValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetContentRspValidationError) ErrorName() string { return "GetContentRspValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetContentRspValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetContentRsp.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetContentRspValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetContentRspValidationError{}
+
+// Validate checks the field values on BatchError with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *BatchError) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BatchError with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in BatchErrorMultiError, or
+// nil if none found.
+func (m *BatchError) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BatchError) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	// no validation rules for Reason
+
+	// no validation rules for Message
+
+	if len(errors) > 0 {
+		return BatchErrorMultiError(errors)
+	}
+
+	return nil
+}
+
+// BatchErrorMultiError is an error wrapping multiple validation errors
+// returned by BatchError.ValidateAll() if the designated constraints aren't met.
+type BatchErrorMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BatchErrorMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BatchErrorMultiError) AllErrors() []error { return m }
+
+// BatchErrorValidationError is the validation error returned by
+// BatchError.Validate if the designated constraints aren't met.
+type BatchErrorValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BatchErrorValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BatchErrorValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BatchErrorValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BatchErrorValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BatchErrorValidationError) ErrorName() string { return "BatchErrorValidationError" }
+
+// Error satisfies the builtin error interface
+func (e BatchErrorValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBatchError.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BatchErrorValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BatchErrorValidationError{}
+
+// Validate checks the field values on BatchResult with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *BatchResult) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BatchResult with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in BatchResultMultiError, or
+// nil if none found.
+func (m *BatchResult) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BatchResult) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetContent()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, BatchResultValidationError{
+					field:  "Content",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, BatchResultValidationError{
+					field:  "Content",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetContent()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return BatchResultValidationError{
+				field:  "Content",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetError()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, BatchResultValidationError{
+					field:  "Error",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, BatchResultValidationError{
+					field:  "Error",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetError()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return BatchResultValidationError{
+				field:  "Error",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return BatchResultMultiError(errors)
+	}
+
+	return nil
+}
+
+// BatchResultMultiError is an error wrapping multiple validation errors
+// returned by BatchResult.ValidateAll() if the designated constraints aren't met.
+type BatchResultMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BatchResultMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BatchResultMultiError) AllErrors() []error { return m }
+
+// BatchResultValidationError is the validation error returned by
+// BatchResult.Validate if the designated constraints aren't met.
+type BatchResultValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BatchResultValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BatchResultValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BatchResultValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BatchResultValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BatchResultValidationError) ErrorName() string { return "BatchResultValidationError" }
+
+// Error satisfies the builtin error interface
+func (e BatchResultValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBatchResult.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BatchResultValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BatchResultValidationError{}
+
+// Validate checks the field values on BatchCreateContentReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BatchCreateContentReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BatchCreateContentReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BatchCreateContentReqMultiError, or nil if none found.
+func (m *BatchCreateContentReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BatchCreateContentReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetContents() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, BatchCreateContentReqValidationError{
+						field:  fmt.Sprintf("Contents[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, BatchCreateContentReqValidationError{
+						field:  fmt.Sprintf("Contents[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return BatchCreateContentReqValidationError{
+					field:  fmt.Sprintf("Contents[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Atomic
+
+	if len(errors) > 0 {
+		return BatchCreateContentReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// BatchCreateContentReqMultiError is an error wrapping multiple validation
+// errors returned by BatchCreateContentReq.ValidateAll() if the designated
+// constraints aren't met.
+type BatchCreateContentReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BatchCreateContentReqMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BatchCreateContentReqMultiError) AllErrors() []error { return m }
+
+// BatchCreateContentReqValidationError is the validation error returned by
+// BatchCreateContentReq.Validate if the designated constraints aren't met.
+type BatchCreateContentReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BatchCreateContentReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BatchCreateContentReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BatchCreateContentReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BatchCreateContentReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BatchCreateContentReqValidationError) ErrorName() string {
+	return "BatchCreateContentReqValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BatchCreateContentReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBatchCreateContentReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BatchCreateContentReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BatchCreateContentReqValidationError{}
+
+// Validate checks the field values on BatchCreateContentRsp with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BatchCreateContentRsp) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BatchCreateContentRsp with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BatchCreateContentRspMultiError, or nil if none found.
+func (m *BatchCreateContentRsp) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BatchCreateContentRsp) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetResults() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, BatchCreateContentRspValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, BatchCreateContentRspValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return BatchCreateContentRspValidationError{
+					field:  fmt.Sprintf("Results[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return BatchCreateContentRspMultiError(errors)
+	}
+
+	return nil
+}
+
+// BatchCreateContentRspMultiError is an error wrapping multiple validation
+// errors returned by BatchCreateContentRsp.ValidateAll() if the designated
+// constraints aren't met.
+type BatchCreateContentRspMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BatchCreateContentRspMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BatchCreateContentRspMultiError) AllErrors() []error { return m }
+
+// BatchCreateContentRspValidationError is the validation error returned by
+// BatchCreateContentRsp.Validate if the designated constraints aren't met.
+type BatchCreateContentRspValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BatchCreateContentRspValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BatchCreateContentRspValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BatchCreateContentRspValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BatchCreateContentRspValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BatchCreateContentRspValidationError) ErrorName() string {
+	return "BatchCreateContentRspValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BatchCreateContentRspValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBatchCreateContentRsp.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BatchCreateContentRspValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BatchCreateContentRspValidationError{}
+
+// Validate checks the field values on BatchGetContentReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BatchGetContentReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BatchGetContentReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BatchGetContentReqMultiError, or nil if none found.
+func (m *BatchGetContentReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BatchGetContentReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return BatchGetContentReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// BatchGetContentReqMultiError is an error wrapping multiple validation errors
+// returned by BatchGetContentReq.ValidateAll() if the designated constraints
+// aren't met.
+type BatchGetContentReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BatchGetContentReqMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BatchGetContentReqMultiError) AllErrors() []error { return m }
+
+// BatchGetContentReqValidationError is the validation error returned by
+// BatchGetContentReq.Validate if the designated constraints aren't met.
+type BatchGetContentReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BatchGetContentReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BatchGetContentReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BatchGetContentReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BatchGetContentReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BatchGetContentReqValidationError) ErrorName() string {
+	return "BatchGetContentReqValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BatchGetContentReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBatchGetContentReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BatchGetContentReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BatchGetContentReqValidationError{}
+
+// Validate checks the field values on BatchGetContentRsp with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BatchGetContentRsp) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BatchGetContentRsp with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BatchGetContentRspMultiError, or nil if none found.
+func (m *BatchGetContentRsp) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BatchGetContentRsp) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetResults() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, BatchGetContentRspValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, BatchGetContentRspValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return BatchGetContentRspValidationError{
+					field:  fmt.Sprintf("Results[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return BatchGetContentRspMultiError(errors)
+	}
+
+	return nil
+}
+
+// BatchGetContentRspMultiError is an error wrapping multiple validation errors
+// returned by BatchGetContentRsp.ValidateAll() if the designated constraints
+// aren't met.
+type BatchGetContentRspMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BatchGetContentRspMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BatchGetContentRspMultiError) AllErrors() []error { return m }
+
+// BatchGetContentRspValidationError is the validation error returned by
+// BatchGetContentRsp.Validate if the designated constraints aren't met.
+type BatchGetContentRspValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BatchGetContentRspValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BatchGetContentRspValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BatchGetContentRspValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BatchGetContentRspValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BatchGetContentRspValidationError) ErrorName() string {
+	return "BatchGetContentRspValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BatchGetContentRspValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBatchGetContentRsp.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BatchGetContentRspValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BatchGetContentRspValidationError{}
+
+// Validate checks the field values on BatchDeleteContentReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BatchDeleteContentReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BatchDeleteContentReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BatchDeleteContentReqMultiError, or nil if none found.
+func (m *BatchDeleteContentReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BatchDeleteContentReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Atomic
+
+	if len(errors) > 0 {
+		return BatchDeleteContentReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// BatchDeleteContentReqMultiError is an error wrapping multiple validation
+// errors returned by BatchDeleteContentReq.ValidateAll() if the designated
+// constraints aren't met.
+type BatchDeleteContentReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BatchDeleteContentReqMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BatchDeleteContentReqMultiError) AllErrors() []error { return m }
+
+// BatchDeleteContentReqValidationError is the validation error returned by
+// BatchDeleteContentReq.Validate if the designated constraints aren't met.
+type BatchDeleteContentReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BatchDeleteContentReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BatchDeleteContentReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BatchDeleteContentReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BatchDeleteContentReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BatchDeleteContentReqValidationError) ErrorName() string {
+	return "BatchDeleteContentReqValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BatchDeleteContentReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBatchDeleteContentReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BatchDeleteContentReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BatchDeleteContentReqValidationError{}
+
+// Validate checks the field values on BatchDeleteContentRsp with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BatchDeleteContentRsp) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BatchDeleteContentRsp with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BatchDeleteContentRspMultiError, or nil if none found.
+func (m *BatchDeleteContentRsp) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BatchDeleteContentRsp) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetResults() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, BatchDeleteContentRspValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, BatchDeleteContentRspValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return BatchDeleteContentRspValidationError{
+					field:  fmt.Sprintf("Results[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return BatchDeleteContentRspMultiError(errors)
+	}
+
+	return nil
+}
+
+// BatchDeleteContentRspMultiError is an error wrapping multiple validation
+// errors returned by BatchDeleteContentRsp.ValidateAll() if the designated
+// constraints aren't met.
+type BatchDeleteContentRspMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BatchDeleteContentRspMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BatchDeleteContentRspMultiError) AllErrors() []error { return m }
+
+// BatchDeleteContentRspValidationError is the validation error returned by
+// BatchDeleteContentRsp.Validate if the designated constraints aren't met.
+type BatchDeleteContentRspValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BatchDeleteContentRspValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BatchDeleteContentRspValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BatchDeleteContentRspValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BatchDeleteContentRspValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BatchDeleteContentRspValidationError) ErrorName() string {
+	return "BatchDeleteContentRspValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BatchDeleteContentRspValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBatchDeleteContentRsp.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BatchDeleteContentRspValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BatchDeleteContentRspValidationError{}
+
+// Validate checks the field values on FindContentReq with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *FindContentReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FindContentReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in FindContentReqMultiError,
+// or nil if none found.
+func (m *FindContentReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FindContentReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Author
+
+	// no validation rules for Title
+
+	// no validation rules for Page
+
+	if val := m.GetPageSize(); val < 0 || val > 100 {
+		err := FindContentReqValidationError{
+			field:  "PageSize",
+			reason: "value must be inside range [0, 100]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for PageToken
+
+	// no validation rules for SkipTotal
+
+	// no validation rules for Category
+
+	for idx, item := range m.GetApprovalStatus() {
+		_, _ = idx, item
+
+		if val := item; val < 0 || val > 3 {
+			err := FindContentReqValidationError{
+				field:  fmt.Sprintf("ApprovalStatus[%v]", idx),
+				reason: "value must be inside range [0, 3]",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if val := m.GetQuality(); val < 0 || val > 3 {
+		err := FindContentReqValidationError{
+			field:  "Quality",
+			reason: "value must be inside range [0, 3]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Format
+
+	// no validation rules for Resolution
+
+	if all {
+		switch v := interface{}(m.GetCreatedAfter()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, FindContentReqValidationError{
+					field:  "CreatedAfter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, FindContentReqValidationError{
+					field:  "CreatedAfter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAfter()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return FindContentReqValidationError{
+				field:  "CreatedAfter",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCreatedBefore()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, FindContentReqValidationError{
+					field:  "CreatedBefore",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, FindContentReqValidationError{
+					field:  "CreatedBefore",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedBefore()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return FindContentReqValidationError{
+				field:  "CreatedBefore",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAfter()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, FindContentReqValidationError{
+					field:  "UpdatedAfter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, FindContentReqValidationError{
+					field:  "UpdatedAfter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAfter()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return FindContentReqValidationError{
+				field:  "UpdatedAfter",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdatedBefore()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, FindContentReqValidationError{
+					field:  "UpdatedBefore",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, FindContentReqValidationError{
+					field:  "UpdatedBefore",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedBefore()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return FindContentReqValidationError{
+				field:  "UpdatedBefore",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if d := m.GetMinDuration(); d != nil {
+		dur, err := d.AsDuration(), d.CheckValid()
+		if err != nil {
+			err = FindContentReqValidationError{
+				field:  "MinDuration",
+				reason: "value is not a valid duration",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+
+			gte := time.Duration(0*time.Second + 0*time.Nanosecond)
+
+			if dur < gte {
+				err := FindContentReqValidationError{
+					field:  "MinDuration",
+					reason: "value must be greater than or equal to 0s",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+		}
+	}
+
+	if d := m.GetMaxDuration(); d != nil {
+		dur, err := d.AsDuration(), d.CheckValid()
+		if err != nil {
+			err = FindContentReqValidationError{
+				field:  "MaxDuration",
+				reason: "value is not a valid duration",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+
+			gte := time.Duration(0*time.Second + 0*time.Nanosecond)
+
+			if dur < gte {
+				err := FindContentReqValidationError{
+					field:  "MaxDuration",
+					reason: "value must be greater than or equal to 0s",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+		}
+	}
+
+	// no validation rules for SortBy
+
+	// no validation rules for Order
+
+	if len(errors) > 0 {
+		return FindContentReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// FindContentReqMultiError is an error wrapping multiple validation errors
+// returned by FindContentReq.ValidateAll() if the designated constraints
+// aren't met.
+type FindContentReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FindContentReqMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FindContentReqMultiError) AllErrors() []error { return m }
+
+// FindContentReqValidationError is the validation error returned by
+// FindContentReq.Validate if the designated constraints aren't met.
+type FindContentReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FindContentReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FindContentReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FindContentReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FindContentReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FindContentReqValidationError) ErrorName() string { return "FindContentReqValidationError" }
+
+// Error satisfies the builtin error interface
+func (e FindContentReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFindContentReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FindContentReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FindContentReqValidationError{}
+
+// Validate checks the field values on FindContentRsp with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *FindContentRsp) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FindContentRsp with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in FindContentRspMultiError,
+// or nil if none found.
+func (m *FindContentRsp) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FindContentRsp) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Total
+
+	for idx, item := range m.GetContents() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, FindContentRspValidationError{
+						field:  fmt.Sprintf("Contents[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, FindContentRspValidationError{
+						field:  fmt.Sprintf("Contents[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return FindContentRspValidationError{
+					field:  fmt.Sprintf("Contents[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return FindContentRspMultiError(errors)
+	}
+
+	return nil
+}
+
+// FindContentRspMultiError is an error wrapping multiple validation errors
+// returned by FindContentRsp.ValidateAll() if the designated constraints
+// aren't met.
+type FindContentRspMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FindContentRspMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FindContentRspMultiError) AllErrors() []error { return m }
+
+// FindContentRspValidationError is the validation error returned by
+// FindContentRsp.Validate if the designated constraints aren't met.
+type FindContentRspValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FindContentRspValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FindContentRspValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FindContentRspValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FindContentRspValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FindContentRspValidationError) ErrorName() string { return "FindContentRspValidationError" }
+
+// Error satisfies the builtin error interface
+func (e FindContentRspValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFindContentRsp.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FindContentRspValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FindContentRspValidationError{}
+
+// Validate checks the field values on SearchContentReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *SearchContentReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SearchContentReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SearchContentReqMultiError, or nil if none found.
+func (m *SearchContentReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SearchContentReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Query
+
+	// no validation rules for Page
+
+	if val := m.GetPageSize(); val < 0 || val > 100 {
+		err := SearchContentReqValidationError{
+			field:  "PageSize",
+			reason: "value must be inside range [0, 100]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return SearchContentReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// SearchContentReqMultiError is an error wrapping multiple validation errors
+// returned by SearchContentReq.ValidateAll() if the designated constraints
+// aren't met.
+type SearchContentReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SearchContentReqMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SearchContentReqMultiError) AllErrors() []error { return m }
+
+// SearchContentReqValidationError is the validation error returned by
+// SearchContentReq.Validate if the designated constraints aren't met.
+type SearchContentReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SearchContentReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SearchContentReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SearchContentReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SearchContentReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SearchContentReqValidationError) ErrorName() string { return "SearchContentReqValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SearchContentReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSearchContentReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SearchContentReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SearchContentReqValidationError{}
+
+// Validate checks the field values on SearchContentRsp with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *SearchContentRsp) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SearchContentRsp with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SearchContentRspMultiError, or nil if none found.
+func (m *SearchContentRsp) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SearchContentRsp) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Total
+
+	for idx, item := range m.GetHits() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SearchContentRspValidationError{
+						field:  fmt.Sprintf("Hits[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SearchContentRspValidationError{
+						field:  fmt.Sprintf("Hits[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SearchContentRspValidationError{
+					field:  fmt.Sprintf("Hits[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return SearchContentRspMultiError(errors)
+	}
+
+	return nil
+}
+
+// SearchContentRspMultiError is an error wrapping multiple validation errors
+// returned by SearchContentRsp.ValidateAll() if the designated constraints
+// aren't met.
+type SearchContentRspMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SearchContentRspMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SearchContentRspMultiError) AllErrors() []error { return m }
+
+// SearchContentRspValidationError is the validation error returned by
+// SearchContentRsp.Validate if the designated constraints aren't met.
+type SearchContentRspValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SearchContentRspValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SearchContentRspValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SearchContentRspValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SearchContentRspValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SearchContentRspValidationError) ErrorName() string { return "SearchContentRspValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SearchContentRspValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSearchContentRsp.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SearchContentRspValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SearchContentRspValidationError{}
+
+// Validate checks the field values on SearchHit with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SearchHit) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SearchHit with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SearchHitMultiError, or nil
+// if none found.
+func (m *SearchHit) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SearchHit) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetContent()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SearchHitValidationError{
+					field:  "Content",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SearchHitValidationError{
+					field:  "Content",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetContent()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SearchHitValidationError{
+				field:  "Content",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Score
+
+	// no validation rules for TitleHighlight
+
+	// no validation rules for DescriptionHighlight
+
+	if len(errors) > 0 {
+		return SearchHitMultiError(errors)
+	}
+
+	return nil
+}
+
+// SearchHitMultiError is an error wrapping multiple validation errors returned
+// by SearchHit.ValidateAll() if the designated constraints aren't met.
+type SearchHitMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SearchHitMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SearchHitMultiError) AllErrors() []error { return m }
+
+// SearchHitValidationError is the validation error returned by
+// SearchHit.Validate if the designated constraints aren't met.
+type SearchHitValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SearchHitValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SearchHitValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SearchHitValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SearchHitValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SearchHitValidationError) ErrorName() string { return "SearchHitValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SearchHitValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSearchHit.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SearchHitValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SearchHitValidationError{}
+
+// Validate checks the field values on ListDeletedContentReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListDeletedContentReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListDeletedContentReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListDeletedContentReqMultiError, or nil if none found.
+func (m *ListDeletedContentReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListDeletedContentReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Page
+
+	if val := m.GetPageSize(); val < 0 || val > 100 {
+		err := ListDeletedContentReqValidationError{
+			field:  "PageSize",
+			reason: "value must be inside range [0, 100]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListDeletedContentReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListDeletedContentReqMultiError is an error wrapping multiple validation
+// errors returned by ListDeletedContentReq.ValidateAll() if the designated
+// constraints aren't met.
+type ListDeletedContentReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListDeletedContentReqMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListDeletedContentReqMultiError) AllErrors() []error { return m }
+
+// ListDeletedContentReqValidationError is the validation error returned by
+// ListDeletedContentReq.Validate if the designated constraints aren't met.
+type ListDeletedContentReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListDeletedContentReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListDeletedContentReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListDeletedContentReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListDeletedContentReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListDeletedContentReqValidationError) ErrorName() string {
+	return "ListDeletedContentReqValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListDeletedContentReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListDeletedContentReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListDeletedContentReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListDeletedContentReqValidationError{}
+
+// Validate checks the field values on ListDeletedContentRsp with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListDeletedContentRsp) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListDeletedContentRsp with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListDeletedContentRspMultiError, or nil if none found.
+func (m *ListDeletedContentRsp) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListDeletedContentRsp) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Total
+
+	for idx, item := range m.GetContents() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListDeletedContentRspValidationError{
+						field:  fmt.Sprintf("Contents[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListDeletedContentRspValidationError{
+						field:  fmt.Sprintf("Contents[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListDeletedContentRspValidationError{
+					field:  fmt.Sprintf("Contents[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListDeletedContentRspMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListDeletedContentRspMultiError is an error wrapping multiple validation
+// errors returned by ListDeletedContentRsp.ValidateAll() if the designated
+// constraints aren't met.
+type ListDeletedContentRspMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListDeletedContentRspMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListDeletedContentRspMultiError) AllErrors() []error { return m }
+
+// ListDeletedContentRspValidationError is the validation error returned by
+// ListDeletedContentRsp.Validate if the designated constraints aren't met.
+type ListDeletedContentRspValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListDeletedContentRspValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListDeletedContentRspValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListDeletedContentRspValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListDeletedContentRspValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListDeletedContentRspValidationError) ErrorName() string {
+	return "ListDeletedContentRspValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListDeletedContentRspValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListDeletedContentRsp.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListDeletedContentRspValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListDeletedContentRspValidationError{}
+
+// Validate checks the field values on RestoreContentReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *RestoreContentReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RestoreContentReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RestoreContentReqMultiError, or nil if none found.
+func (m *RestoreContentReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RestoreContentReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return RestoreContentReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// RestoreContentReqMultiError is an error wrapping multiple validation errors
+// returned by RestoreContentReq.ValidateAll() if the designated constraints
+// aren't met.
+type RestoreContentReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RestoreContentReqMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RestoreContentReqMultiError) AllErrors() []error { return m }
+
+// RestoreContentReqValidationError is the validation error returned by
+// RestoreContentReq.Validate if the designated constraints aren't met.
+type RestoreContentReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RestoreContentReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RestoreContentReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RestoreContentReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RestoreContentReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RestoreContentReqValidationError) ErrorName() string {
+	return "RestoreContentReqValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RestoreContentReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRestoreContentReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RestoreContentReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RestoreContentReqValidationError{}
+
+// Validate checks the field values on RestoreContentRsp with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *RestoreContentRsp) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RestoreContentRsp with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RestoreContentRspMultiError, or nil if none found.
+func (m *RestoreContentRsp) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RestoreContentRsp) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return RestoreContentRspMultiError(errors)
+	}
+
+	return nil
+}
+
+// RestoreContentRspMultiError is an error wrapping multiple validation errors
+// returned by RestoreContentRsp.ValidateAll() if the designated constraints
+// aren't met.
+type RestoreContentRspMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RestoreContentRspMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RestoreContentRspMultiError) AllErrors() []error { return m }
+
+// RestoreContentRspValidationError is the validation error returned by
+// RestoreContentRsp.Validate if the designated constraints aren't met.
+type RestoreContentRspValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RestoreContentRspValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RestoreContentRspValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RestoreContentRspValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RestoreContentRspValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RestoreContentRspValidationError) ErrorName() string {
+	return "RestoreContentRspValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RestoreContentRspValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRestoreContentRsp.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RestoreContentRspValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RestoreContentRspValidationError{}
+
+// Validate checks the field values on PurgeContentReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *PurgeContentReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PurgeContentReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PurgeContentReqMultiError, or nil if none found.
+func (m *PurgeContentReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PurgeContentReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return PurgeContentReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// PurgeContentReqMultiError is an error wrapping multiple validation errors
+// returned by PurgeContentReq.ValidateAll() if the designated constraints
+// aren't met.
+type PurgeContentReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PurgeContentReqMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PurgeContentReqMultiError) AllErrors() []error { return m }
+
+// PurgeContentReqValidationError is the validation error returned by
+// PurgeContentReq.Validate if the designated constraints aren't met.
+type PurgeContentReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PurgeContentReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PurgeContentReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PurgeContentReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PurgeContentReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PurgeContentReqValidationError) ErrorName() string { return "PurgeContentReqValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PurgeContentReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPurgeContentReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PurgeContentReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PurgeContentReqValidationError{}
+
+// Validate checks the field values on PurgeContentRsp with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *PurgeContentRsp) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PurgeContentRsp with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PurgeContentRspMultiError, or nil if none found.
+func (m *PurgeContentRsp) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PurgeContentRsp) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return PurgeContentRspMultiError(errors)
+	}
+
+	return nil
+}
+
+// PurgeContentRspMultiError is an error wrapping multiple validation errors
+// returned by PurgeContentRsp.ValidateAll() if the designated constraints
+// aren't met.
+type PurgeContentRspMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PurgeContentRspMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PurgeContentRspMultiError) AllErrors() []error { return m }
+
+// PurgeContentRspValidationError is the validation error returned by
+// PurgeContentRsp.Validate if the designated constraints aren't met.
+type PurgeContentRspValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PurgeContentRspValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PurgeContentRspValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PurgeContentRspValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PurgeContentRspValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PurgeContentRspValidationError) ErrorName() string { return "PurgeContentRspValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PurgeContentRspValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPurgeContentRsp.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PurgeContentRspValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PurgeContentRspValidationError{}
+
+// Validate checks the field values on Revision with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Revision) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Revision with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in RevisionMultiError, or nil
+// if none found.
+func (m *Revision) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Revision) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ContentId
+
+	// no validation rules for Revision
+
+	// no validation rules for Editor
+
+	if all {
+		switch v := interface{}(m.GetContent()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RevisionValidationError{
+					field:  "Content",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RevisionValidationError{
+					field:  "Content",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetContent()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RevisionValidationError{
+				field:  "Content",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RevisionValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RevisionValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RevisionValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return RevisionMultiError(errors)
+	}
+
+	return nil
+}
+
+// RevisionMultiError is an error wrapping multiple validation errors returned
+// by Revision.ValidateAll() if the designated constraints aren't met.
+type RevisionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RevisionMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RevisionMultiError) AllErrors() []error { return m }
+
+// RevisionValidationError is the validation error returned by
+// Revision.Validate if the designated constraints aren't met.
+type RevisionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RevisionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RevisionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RevisionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RevisionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RevisionValidationError) ErrorName() string { return "RevisionValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RevisionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRevision.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RevisionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RevisionValidationError{}
+
+// Validate checks the field values on ListRevisionsReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListRevisionsReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListRevisionsReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListRevisionsReqMultiError, or nil if none found.
+func (m *ListRevisionsReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListRevisionsReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Page
+
+	if val := m.GetPageSize(); val < 0 || val > 100 {
+		err := ListRevisionsReqValidationError{
+			field:  "PageSize",
+			reason: "value must be inside range [0, 100]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListRevisionsReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListRevisionsReqMultiError is an error wrapping multiple validation errors
+// returned by ListRevisionsReq.ValidateAll() if the designated constraints
+// aren't met.
+type ListRevisionsReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListRevisionsReqMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListRevisionsReqMultiError) AllErrors() []error { return m }
+
+// ListRevisionsReqValidationError is the validation error returned by
+// ListRevisionsReq.Validate if the designated constraints aren't met.
+type ListRevisionsReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListRevisionsReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListRevisionsReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListRevisionsReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListRevisionsReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListRevisionsReqValidationError) ErrorName() string { return "ListRevisionsReqValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ListRevisionsReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListRevisionsReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListRevisionsReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListRevisionsReqValidationError{}
+
+// Validate checks the field values on ListRevisionsRsp with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListRevisionsRsp) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListRevisionsRsp with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListRevisionsRspMultiError, or nil if none found.
+func (m *ListRevisionsRsp) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListRevisionsRsp) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Total
+
+	for idx, item := range m.GetRevisions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListRevisionsRspValidationError{
+						field:  fmt.Sprintf("Revisions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListRevisionsRspValidationError{
+						field:  fmt.Sprintf("Revisions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListRevisionsRspValidationError{
+					field:  fmt.Sprintf("Revisions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListRevisionsRspMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListRevisionsRspMultiError is an error wrapping multiple validation errors
+// returned by ListRevisionsRsp.ValidateAll() if the designated constraints
+// aren't met.
+type ListRevisionsRspMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListRevisionsRspMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListRevisionsRspMultiError) AllErrors() []error { return m }
+
+// ListRevisionsRspValidationError is the validation error returned by
+// ListRevisionsRsp.Validate if the designated constraints aren't met.
+type ListRevisionsRspValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListRevisionsRspValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListRevisionsRspValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListRevisionsRspValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListRevisionsRspValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListRevisionsRspValidationError) ErrorName() string { return "ListRevisionsRspValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ListRevisionsRspValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListRevisionsRsp.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListRevisionsRspValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListRevisionsRspValidationError{}
+
+// Validate checks the field values on GetRevisionReq with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GetRevisionReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetRevisionReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GetRevisionReqMultiError,
+// or nil if none found.
+func (m *GetRevisionReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetRevisionReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Revision
+
+	if len(errors) > 0 {
+		return GetRevisionReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetRevisionReqMultiError is an error wrapping multiple validation errors
+// returned by GetRevisionReq.ValidateAll() if the designated constraints
+// aren't met.
+type GetRevisionReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetRevisionReqMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetRevisionReqMultiError) AllErrors() []error { return m }
+
+// GetRevisionReqValidationError is the validation error returned by
+// GetRevisionReq.Validate if the designated constraints aren't met.
+type GetRevisionReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetRevisionReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetRevisionReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetRevisionReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetRevisionReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetRevisionReqValidationError) ErrorName() string { return "GetRevisionReqValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetRevisionReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetRevisionReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetRevisionReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetRevisionReqValidationError{}
+
+// Validate checks the field values on GetRevisionRsp with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GetRevisionRsp) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetRevisionRsp with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GetRevisionRspMultiError,
+// or nil if none found.
+func (m *GetRevisionRsp) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetRevisionRsp) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetRevision()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetRevisionRspValidationError{
+					field:  "Revision",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetRevisionRspValidationError{
+					field:  "Revision",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRevision()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetRevisionRspValidationError{
+				field:  "Revision",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetRevisionRspMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetRevisionRspMultiError is an error wrapping multiple validation errors
+// returned by GetRevisionRsp.ValidateAll() if the designated constraints
+// aren't met.
+type GetRevisionRspMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetRevisionRspMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetRevisionRspMultiError) AllErrors() []error { return m }
+
+// GetRevisionRspValidationError is the validation error returned by
+// GetRevisionRsp.Validate if the designated constraints aren't met.
+type GetRevisionRspValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetRevisionRspValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetRevisionRspValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetRevisionRspValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetRevisionRspValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetRevisionRspValidationError) ErrorName() string { return "GetRevisionRspValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetRevisionRspValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetRevisionRsp.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetRevisionRspValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetRevisionRspValidationError{}
+
+// Validate checks the field values on DiffRevisionsReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *DiffRevisionsReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DiffRevisionsReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DiffRevisionsReqMultiError, or nil if none found.
+func (m *DiffRevisionsReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DiffRevisionsReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for From
+
+	// no validation rules for To
+
+	if len(errors) > 0 {
+		return DiffRevisionsReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// DiffRevisionsReqMultiError is an error wrapping multiple validation errors
+// returned by DiffRevisionsReq.ValidateAll() if the designated constraints
+// aren't met.
+type DiffRevisionsReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DiffRevisionsReqMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DiffRevisionsReqMultiError) AllErrors() []error { return m }
+
+// DiffRevisionsReqValidationError is the validation error returned by
+// DiffRevisionsReq.Validate if the designated constraints aren't met.
+type DiffRevisionsReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DiffRevisionsReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DiffRevisionsReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DiffRevisionsReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DiffRevisionsReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DiffRevisionsReqValidationError) ErrorName() string { return "DiffRevisionsReqValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DiffRevisionsReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDiffRevisionsReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DiffRevisionsReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DiffRevisionsReqValidationError{}
+
+// Validate checks the field values on FieldDiff with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *FieldDiff) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FieldDiff with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in FieldDiffMultiError, or nil
+// if none found.
+func (m *FieldDiff) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FieldDiff) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Field
+
+	// no validation rules for From
+
+	// no validation rules for To
+
+	if len(errors) > 0 {
+		return FieldDiffMultiError(errors)
+	}
+
+	return nil
+}
+
+// FieldDiffMultiError is an error wrapping multiple validation errors returned
+// by FieldDiff.ValidateAll() if the designated constraints aren't met.
+type FieldDiffMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FieldDiffMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FieldDiffMultiError) AllErrors() []error { return m }
+
+// FieldDiffValidationError is the validation error returned by
+// FieldDiff.Validate if the designated constraints aren't met.
+type FieldDiffValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FieldDiffValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FieldDiffValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FieldDiffValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FieldDiffValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FieldDiffValidationError) ErrorName() string { return "FieldDiffValidationError" }
+
+// Error satisfies the builtin error interface
+func (e FieldDiffValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFieldDiff.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FieldDiffValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FieldDiffValidationError{}
+
+// Validate checks the field values on DiffRevisionsRsp with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *DiffRevisionsRsp) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DiffRevisionsRsp with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DiffRevisionsRspMultiError, or nil if none found.
+func (m *DiffRevisionsRsp) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DiffRevisionsRsp) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetDiffs() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, DiffRevisionsRspValidationError{
+						field:  fmt.Sprintf("Diffs[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, DiffRevisionsRspValidationError{
+						field:  fmt.Sprintf("Diffs[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return DiffRevisionsRspValidationError{
+					field:  fmt.Sprintf("Diffs[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return DiffRevisionsRspMultiError(errors)
+	}
+
+	return nil
+}
+
+// DiffRevisionsRspMultiError is an error wrapping multiple validation errors
+// returned by DiffRevisionsRsp.ValidateAll() if the designated constraints
+// aren't met.
+type DiffRevisionsRspMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DiffRevisionsRspMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DiffRevisionsRspMultiError) AllErrors() []error { return m }
+
+// DiffRevisionsRspValidationError is the validation error returned by
+// DiffRevisionsRsp.Validate if the designated constraints aren't met.
+type DiffRevisionsRspValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DiffRevisionsRspValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DiffRevisionsRspValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DiffRevisionsRspValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DiffRevisionsRspValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DiffRevisionsRspValidationError) ErrorName() string { return "DiffRevisionsRspValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DiffRevisionsRspValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDiffRevisionsRsp.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DiffRevisionsRspValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DiffRevisionsRspValidationError{}
+
+// Validate checks the field values on RollbackContentReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RollbackContentReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RollbackContentReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RollbackContentReqMultiError, or nil if none found.
+func (m *RollbackContentReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RollbackContentReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Revision
+
+	// no validation rules for Editor
+
+	if len(errors) > 0 {
+		return RollbackContentReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// RollbackContentReqMultiError is an error wrapping multiple validation errors
+// returned by RollbackContentReq.ValidateAll() if the designated constraints
+// aren't met.
+type RollbackContentReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RollbackContentReqMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RollbackContentReqMultiError) AllErrors() []error { return m }
+
+// RollbackContentReqValidationError is the validation error returned by
+// RollbackContentReq.Validate if the designated constraints aren't met.
+type RollbackContentReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RollbackContentReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RollbackContentReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RollbackContentReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RollbackContentReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RollbackContentReqValidationError) ErrorName() string {
+	return "RollbackContentReqValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RollbackContentReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRollbackContentReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RollbackContentReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RollbackContentReqValidationError{}
+
+// Validate checks the field values on RollbackContentRsp with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RollbackContentRsp) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RollbackContentRsp with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RollbackContentRspMultiError, or nil if none found.
+func (m *RollbackContentRsp) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RollbackContentRsp) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Revision
+
+	if len(errors) > 0 {
+		return RollbackContentRspMultiError(errors)
+	}
+
+	return nil
+}
+
+// RollbackContentRspMultiError is an error wrapping multiple validation errors
+// returned by RollbackContentRsp.ValidateAll() if the designated constraints
+// aren't met.
+type RollbackContentRspMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RollbackContentRspMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RollbackContentRspMultiError) AllErrors() []error { return m }
+
+// RollbackContentRspValidationError is the validation error returned by
+// RollbackContentRsp.Validate if the designated constraints aren't met.
+type RollbackContentRspValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RollbackContentRspValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RollbackContentRspValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RollbackContentRspValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RollbackContentRspValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RollbackContentRspValidationError) ErrorName() string {
+	return "RollbackContentRspValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RollbackContentRspValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRollbackContentRsp.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RollbackContentRspValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RollbackContentRspValidationError{}
+
+// Validate checks the field values on WatchContentReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *WatchContentReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WatchContentReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WatchContentReqMultiError, or nil if none found.
+func (m *WatchContentReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WatchContentReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Author
+
+	// no validation rules for Category
+
+	for idx, item := range m.GetApprovalStatus() {
+		_, _ = idx, item
+
+		if val := item; val < 0 || val > 3 {
+			err := WatchContentReqValidationError{
+				field:  fmt.Sprintf("ApprovalStatus[%v]", idx),
+				reason: "value must be inside range [0, 3]",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	// no validation rules for ResumeToken
+
+	if len(errors) > 0 {
+		return WatchContentReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// WatchContentReqMultiError is an error wrapping multiple validation errors
+// returned by WatchContentReq.ValidateAll() if the designated constraints
+// aren't met.
+type WatchContentReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WatchContentReqMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WatchContentReqMultiError) AllErrors() []error { return m }
+
+// WatchContentReqValidationError is the validation error returned by
+// WatchContentReq.Validate if the designated constraints aren't met.
+type WatchContentReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WatchContentReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WatchContentReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WatchContentReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WatchContentReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WatchContentReqValidationError) ErrorName() string { return "WatchContentReqValidationError" }
+
+// Error satisfies the builtin error interface
+func (e WatchContentReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWatchContentReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WatchContentReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WatchContentReqValidationError{}
+
+// Validate checks the field values on WatchContentRsp with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *WatchContentRsp) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WatchContentRsp with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WatchContentRspMultiError, or nil if none found.
+func (m *WatchContentRsp) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WatchContentRsp) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Type
+
+	if all {
+		switch v := interface{}(m.GetContent()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, WatchContentRspValidationError{
+					field:  "Content",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, WatchContentRspValidationError{
+					field:  "Content",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetContent()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WatchContentRspValidationError{
+				field:  "Content",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Editor
+
+	// no validation rules for ApprovalFrom
+
+	// no validation rules for EventId
+
+	if all {
+		switch v := interface{}(m.GetOccurredAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, WatchContentRspValidationError{
+					field:  "OccurredAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, WatchContentRspValidationError{
+					field:  "OccurredAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetOccurredAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WatchContentRspValidationError{
+				field:  "OccurredAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for ResumeToken
+
+	// no validation rules for Heartbeat
+
+	if len(errors) > 0 {
+		return WatchContentRspMultiError(errors)
+	}
+
+	return nil
+}
+
+// WatchContentRspMultiError is an error wrapping multiple validation errors
+// returned by WatchContentRsp.ValidateAll() if the designated constraints
+// aren't met.
+type WatchContentRspMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WatchContentRspMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WatchContentRspMultiError) AllErrors() []error { return m }
+
+// WatchContentRspValidationError is the validation error returned by
+// WatchContentRsp.Validate if the designated constraints aren't met.
+type WatchContentRspValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WatchContentRspValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WatchContentRspValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WatchContentRspValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WatchContentRspValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WatchContentRspValidationError) ErrorName() string { return "WatchContentRspValidationError" }
+
+// Error satisfies the builtin error interface
+func (e WatchContentRspValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWatchContentRsp.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WatchContentRspValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WatchContentRspValidationError{}
@@ -5,6 +5,7 @@ import (
 	"os"
 
 	"content_manage/internal/conf"
+	"content_manage/internal/server"
 
 	"github.com/go-kratos/kratos/contrib/registry/etcd/v2"
 	"github.com/go-kratos/kratos/v2"
@@ -13,7 +14,9 @@ import (
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/go-kratos/kratos/v2/middleware/tracing"
 	"github.com/go-kratos/kratos/v2/transport/grpc"
+	"github.com/go-kratos/kratos/v2/transport/http"
 
+	shard "github.com/zerokkcoder/content-shard"
 	_ "go.uber.org/automaxprocs"
 
 	clientv3 "go.etcd.io/etcd/client/v3"
@@ -35,13 +38,7 @@ func init() {
 	flag.StringVar(&flagconf, "conf", "../../configs", "config path, eg: -conf config.yaml")
 }
 
-func newApp(logger log.Logger, gs *grpc.Server) *kratos.App {
-	client, err := clientv3.New(clientv3.Config{
-		Endpoints: []string{"127.0.0.1:2379"},
-	})
-	if err != nil {
-		panic(err)
-	}
+func newApp(logger log.Logger, gs *grpc.Server, hs *http.Server, ts *server.TrashServer, obs *server.OutboxServer, router *shard.Router, client *clientv3.Client) *kratos.App {
 	// new reg with etcd client
 	reg := etcd.New(client)
 
@@ -49,10 +46,16 @@ func newApp(logger log.Logger, gs *grpc.Server) *kratos.App {
 		kratos.ID(id),
 		kratos.Name(Name),
 		kratos.Version(Version),
-		kratos.Metadata(map[string]string{}),
+		// 注册分片拓扑版本, 便于其他服务确认路由一致
+		kratos.Metadata(map[string]string{
+			"shard_topology": router.Version(),
+		}),
 		kratos.Logger(logger),
 		kratos.Server(
 			gs,
+			hs,
+			ts,
+			obs,
 		),
 		// with registar
 		kratos.Registrar(reg),
@@ -24,16 +24,64 @@ import (
 
 // wireApp init kratos application.
 func wireApp(confServer *conf.Server, confData *conf.Data, logger log.Logger) (*kratos.App, func(), error) {
-	dataData, cleanup, err := data.NewData(confData, logger)
+	router, err := data.NewShardRouter(confData)
 	if err != nil {
 		return nil, nil, err
 	}
-	contentRepo := data.NewContentRepo(dataData, logger)
-	contentUsecase := biz.NewContentUsecase(contentRepo, logger)
+	dataData, cleanup, err := data.NewData(confData, router, logger)
+	if err != nil {
+		return nil, nil, err
+	}
+	contentRepo := data.NewContentRepo(confData, dataData, logger)
+	searchRepo, cleanup2, err := data.NewSearchRepo(confData, logger)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	revisionRepo := data.NewRevisionRepo(dataData, logger)
+	idempotencyRepo, cleanup3, err := data.NewIdempotencyRepo(confData, dataData, logger)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	outboxRepo := data.NewOutboxRepo(dataData, logger)
+	eventBus, err := data.NewEventBus(confData, dataData, logger)
+	if err != nil {
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	client, cleanup4, err := data.NewEtcdClient(confData)
+	if err != nil {
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	idGenerator, cleanup5, err := data.NewIDGenerator(confData, client, logger)
+	if err != nil {
+		cleanup4()
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	contentUsecase := biz.NewContentUsecase(contentRepo, searchRepo, revisionRepo, idempotencyRepo, outboxRepo, eventBus, idGenerator, logger)
 	appService := service.NewAppService(contentUsecase)
 	grpcServer := server.NewGRPCServer(confServer, appService, logger)
-	app := newApp(logger, grpcServer)
+	httpServer := server.NewHTTPServer(confServer, appService, router, logger)
+	trashServer := server.NewTrashServer(confData, contentUsecase, logger)
+	flowTrigger := data.NewFlowTrigger(confData)
+	outboxUsecase := biz.NewOutboxUsecase(outboxRepo, flowTrigger, eventBus, logger)
+	outboxServer := server.NewOutboxServer(confData, outboxUsecase, logger)
+	app := newApp(logger, grpcServer, httpServer, trashServer, outboxServer, router, client)
 	return app, func() {
+		cleanup5()
+		cleanup4()
+		cleanup3()
+		cleanup2()
 		cleanup()
 	}, nil
 }
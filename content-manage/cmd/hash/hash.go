@@ -1,36 +1,41 @@
 package main
 
 import (
+	"flag"
 	"fmt"
-	"hash/fnv"
-	"math/big"
 
-	"github.com/go-kratos/kratos/v2/log"
 	"github.com/google/uuid"
+	shard "github.com/zerokkcoder/content-shard"
 )
 
-const contentNumTables = 4
-
-func main() {
-	u := uuid.New().String()
-	getContentDetailTable(u)
-}
+var (
+	strategy  string
+	numShards int
+	table     string
+	contentID string
+)
 
-func getContentDetailTable(contentID string) string {
-	tableIndex := getContentTableIndex(contentID)
-	table := fmt.Sprintf("cms_content.t_content_details_%d", tableIndex)
-	log.Infof("content_id = %s, table = %s", contentID, table)
-	return table
+func init() {
+	flag.StringVar(&strategy, "strategy", string(shard.StrategyMod), "shard strategy: mod, ring, range")
+	flag.IntVar(&numShards, "shards", 4, "number of detail tables")
+	flag.StringVar(&table, "table", "cms_content.t_content_details_%d", "detail table format")
+	flag.StringVar(&contentID, "content_id", "", "content id to route, random uuid if empty")
 }
 
-func getContentTableIndex(uuid string) int {
-	hash := fnv.New64()
-	hash.Write([]byte(uuid))
-	hashValue := hash.Sum64()
-	fmt.Println("hashValue = ", hashValue)
-
-	bigNum := big.NewInt(int64(hashValue))
-	mod := big.NewInt(contentNumTables)
-	tableIndex := bigNum.Mod(bigNum, mod).Int64()
-	return int(tableIndex)
+// 计算 content_id 所在的详情表以及分片配置的拓扑版本
+func main() {
+	flag.Parse()
+	router, err := shard.New(shard.Config{
+		Strategy: shard.Strategy(strategy),
+		Shards:   numShards,
+		Table:    table,
+	})
+	if err != nil {
+		panic(err)
+	}
+	if contentID == "" {
+		contentID = uuid.New().String()
+	}
+	fmt.Printf("topology version = %s\n", router.Version())
+	fmt.Printf("content_id = %s, table = %s\n", contentID, router.Table(contentID))
 }
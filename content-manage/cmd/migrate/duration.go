@@ -0,0 +1,61 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+// durationSQL 时长统一为纳秒, 主库还需修改索引表和历史版本快照
+func durationSQL(w io.Writer, primary bool, tables []string) {
+	if primary {
+		tables = append(tables, "cms_content.t_idx_content_details")
+	}
+	fmt.Fprint(w, `-- 时长统一为纳秒, 与 time.Duration 和 google.protobuf.Duration 一致
+-- 旧版网关按微秒传入, content-manage 按纳秒原样写入, 已有数据需乘以 1000
+-- 执行记录在本库的 t_schema_migrations 中, 重复执行时不再修改数据
+-- 执行前停止写入, 执行后清理内容缓存 cms:content:*, 缓存中仍是旧值
+
+-- int 改为 bigint 避免纳秒溢出
+`)
+	for _, table := range tables {
+		fmt.Fprintf(w, "ALTER TABLE %s\n    MODIFY COLUMN `duration` bigint NOT NULL DEFAULT 0 COMMENT '时长, 单位纳秒';\n", quoteTable(table))
+	}
+	fmt.Fprint(w, `
+CREATE TABLE IF NOT EXISTS `+"`cms_content`.`t_schema_migrations`"+` (
+    `+"`name`"+` varchar(64) NOT NULL COMMENT '迁移名称',
+    `+"`applied_at`"+` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '执行时间',
+    PRIMARY KEY (`+"`name`"+`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='已执行的数据迁移';
+
+-- 数据修改和执行记录在同一个事务中, 已有记录时 @apply 为 0, 以下修改都不生效
+START TRANSACTION;
+SELECT COUNT(*) = 0 INTO @apply FROM `+"`cms_content`.`t_schema_migrations`"+` WHERE `+"`name`"+` = 'duration_ns' FOR UPDATE;
+
+-- updated_at 保持不变, 迁移不算作修改
+`)
+	for _, table := range tables {
+		fmt.Fprintf(w, "UPDATE %s SET `duration` = `duration` * 1000, `updated_at` = `updated_at` WHERE @apply;\n", quoteTable(table))
+	}
+	if primary {
+		fmt.Fprint(w, `
+-- 历史版本快照中的时长同样按纳秒保存
+UPDATE `+"`cms_content`.`t_content_revisions`"+`
+SET `+"`snapshot`"+` = JSON_SET(`+"`snapshot`"+`, '$.duration', CAST(JSON_EXTRACT(`+"`snapshot`"+`, '$.duration') AS SIGNED) * 1000)
+WHERE @apply AND JSON_EXTRACT(`+"`snapshot`"+`, '$.duration') IS NOT NULL;
+`)
+	}
+	fmt.Fprint(w, `
+INSERT IGNORE INTO `+"`cms_content`.`t_schema_migrations`"+` (`+"`name`"+`) VALUES ('duration_ns');
+COMMIT;
+`)
+}
+
+// quoteTable cms_content.t_content_details_0 -> `cms_content`.`t_content_details_0`
+func quoteTable(table string) string {
+	parts := strings.Split(table, ".")
+	for i, part := range parts {
+		parts[i] = "`" + part + "`"
+	}
+	return strings.Join(parts, ".")
+}
@@ -0,0 +1,101 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"content_manage/internal/conf"
+	"content_manage/internal/data"
+
+	"github.com/go-kratos/kratos/v2/config"
+	"github.com/go-kratos/kratos/v2/config/file"
+)
+
+var (
+	// flagconf is the config flag.
+	flagconf string
+	database string
+)
+
+func init() {
+	flag.StringVar(&flagconf, "conf", "../../configs", "config path, eg: -conf config.yaml")
+	flag.StringVar(&database, "database", "", "only print the statements of this database, eg: primary, shard_a")
+}
+
+const usage = `usage: migrate [-conf path] [-database name] <migration>
+
+migrations:
+  duration  store durations in nanoseconds, see script/duration.sql
+
+Statements are grouped by physical database and must be run against the
+database named in each "-- database:" header.
+`
+
+// 按配置中的分片布局生成数据迁移语句, 详情表分布在多个物理库或正在重分片时, 每个库执行各自的语句
+func main() {
+	flag.Usage = func() { fmt.Fprint(os.Stderr, usage) }
+	flag.Parse()
+	if flag.NArg() != 1 {
+		flag.Usage()
+		os.Exit(2)
+	}
+	c := config.New(
+		config.WithSource(
+			file.NewSource(flagconf),
+		),
+	)
+	defer c.Close()
+
+	if err := c.Load(); err != nil {
+		panic(err)
+	}
+
+	var bc conf.Bootstrap
+	if err := c.Scan(&bc); err != nil {
+		panic(err)
+	}
+
+	var migrate func(w io.Writer, primary bool, tables []string)
+	switch flag.Arg(0) {
+	case "duration":
+		migrate = durationSQL
+	default:
+		flag.Usage()
+		os.Exit(2)
+	}
+	if err := generate(os.Stdout, bc.Data, database, migrate); err != nil {
+		fmt.Fprintln(os.Stderr, "error:", err)
+		os.Exit(1)
+	}
+}
+
+// generate 依次输出主库和各分片库的语句, 分片库按 shard_databases 的顺序, 没有详情表的库跳过
+func generate(w io.Writer, c *conf.Data, only string, migrate func(w io.Writer, primary bool, tables []string)) error {
+	tables, err := data.DetailTables(c)
+	if err != nil {
+		return err
+	}
+	names := []string{data.PrimaryDatabase}
+	for _, sc := range c.GetShardDatabases() {
+		names = append(names, sc.GetName())
+	}
+	found := false
+	for _, name := range names {
+		primary := name == data.PrimaryDatabase
+		if (only != "" && name != only) || (!primary && len(tables[name]) == 0) {
+			continue
+		}
+		if found {
+			fmt.Fprintln(w)
+		}
+		found = true
+		fmt.Fprintf(w, "-- database: %s\n", name)
+		migrate(w, primary, tables[name])
+	}
+	if !found {
+		return fmt.Errorf("no statements for database %s", only)
+	}
+	return nil
+}
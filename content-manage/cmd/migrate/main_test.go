@@ -0,0 +1,118 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"slices"
+	"strings"
+	"testing"
+
+	"content_manage/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/config"
+	"github.com/go-kratos/kratos/v2/config/file"
+)
+
+// TestDurationScript script/duration.sql 与默认配置生成的语句一致
+func TestDurationScript(t *testing.T) {
+	c := config.New(config.WithSource(file.NewSource("../../configs")))
+	defer c.Close()
+	if err := c.Load(); err != nil {
+		t.Fatal(err)
+	}
+	var bc conf.Bootstrap
+	if err := c.Scan(&bc); err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	if err := generate(&buf, bc.Data, "", durationSQL); err != nil {
+		t.Fatal(err)
+	}
+	script, err := os.ReadFile("../../script/duration.sql")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 跳过文件开头的说明
+	i := bytes.Index(script, []byte("-- database: "))
+	if i < 0 || !bytes.Equal(script[i:], buf.Bytes()) {
+		t.Fatalf("script/duration.sql is out of date, regenerate it with: go run ./cmd/migrate -conf configs duration")
+	}
+}
+
+// blocks 按 "-- database:" 拆分输出
+func blocks(out string) map[string]string {
+	m := make(map[string]string)
+	for _, block := range strings.Split(out, "-- database: ")[1:] {
+		name, body, _ := strings.Cut(block, "\n")
+		m[name] = body
+	}
+	return m
+}
+
+func TestGenerateShardDatabases(t *testing.T) {
+	c := &conf.Data{
+		Shard: &conf.Data_Shard{
+			Strategy:  "mod",
+			Shards:    4,
+			Table:     "cms_content.t_content_details_%d",
+			Databases: []string{"shard_a", "shard_a", "", "shard_b"},
+		},
+		Reshard: &conf.Data_Reshard{Target: &conf.Data_Shard{
+			Strategy:  "mod",
+			Shards:    4,
+			Table:     "cms_content.t_content_details_v2_%d",
+			Databases: []string{"shard_b", "shard_b", "shard_c", "shard_c"},
+		}},
+		ShardDatabases: []*conf.Data_ShardDatabase{{Name: "shard_a"}, {Name: "shard_b"}, {Name: "shard_c"}, {Name: "shard_d"}},
+	}
+	var buf bytes.Buffer
+	if err := generate(&buf, c, "", durationSQL); err != nil {
+		t.Fatal(err)
+	}
+	got := blocks(buf.String())
+	want := map[string][]string{
+		"primary": {"t_content_details_2", "t_idx_content_details", "t_content_revisions"},
+		"shard_a": {"t_content_details_0", "t_content_details_1"},
+		"shard_b": {"t_content_details_3", "t_content_details_v2_0", "t_content_details_v2_1"},
+		"shard_c": {"t_content_details_v2_2", "t_content_details_v2_3"},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("databases = %d, want %d without the empty shard_d", len(got), len(want))
+	}
+	all := []string{"t_content_details_0", "t_content_details_1", "t_content_details_2", "t_content_details_3",
+		"t_content_details_v2_0", "t_content_details_v2_1", "t_content_details_v2_2", "t_content_details_v2_3",
+		"t_idx_content_details", "t_content_revisions"}
+	for name, tables := range want {
+		body, ok := got[name]
+		if !ok {
+			t.Fatalf("database %s missing", name)
+		}
+		for _, table := range all {
+			in := strings.Contains(body, "`"+table+"`")
+			if in != slices.Contains(tables, table) {
+				t.Fatalf("database %s contains %s = %v", name, table, in)
+			}
+		}
+		// 每个库各自记录迁移, 重复执行时不再修改
+		if !strings.Contains(body, "INSERT IGNORE INTO `cms_content`.`t_schema_migrations`") {
+			t.Fatalf("database %s does not record the migration", name)
+		}
+	}
+
+	buf.Reset()
+	if err := generate(&buf, c, "shard_c", durationSQL); err != nil {
+		t.Fatal(err)
+	}
+	if got := blocks(buf.String()); len(got) != 1 || got["shard_c"] == "" {
+		t.Fatalf("-database shard_c printed %v", got)
+	}
+	if err := generate(&buf, c, "shard_d", durationSQL); err == nil {
+		t.Fatal("generate(shard_d) error = nil, want no statements for a database without detail tables")
+	}
+
+	// 分片库数量与分片数不一致时拒绝生成
+	c.Shard.Databases = c.Shard.Databases[:3]
+	if err := generate(&buf, c, "", durationSQL); err == nil {
+		t.Fatal("generate() error = nil, want the databases mismatch")
+	}
+}
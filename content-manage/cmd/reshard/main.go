@@ -0,0 +1,117 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+
+	"content_manage/internal/biz"
+	"content_manage/internal/conf"
+	"content_manage/internal/data"
+
+	"github.com/go-kratos/kratos/v2/config"
+	"github.com/go-kratos/kratos/v2/config/file"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// flagconf is the config flag.
+var flagconf string
+
+func init() {
+	flag.StringVar(&flagconf, "conf", "../../configs", "config path, eg: -conf config.yaml")
+}
+
+const usage = `usage: reshard [-conf path] <command>
+
+commands:
+  status    show the progress of the current job
+  start     create the target tables and start dual writing
+  backfill  copy existing rows into the target layout, resumable
+  verify    compare both layouts and repair differences, resumable
+  cutover   switch reads to the target layout
+  finish    stop writing the old layout and clean up reused tables
+  abort     fall back to the old layout
+`
+
+// 在线重分片管理命令, 按 start -> backfill -> verify -> cutover -> finish 的顺序执行
+func main() {
+	flag.Usage = func() { fmt.Fprint(os.Stderr, usage) }
+	flag.Parse()
+	if flag.NArg() != 1 {
+		flag.Usage()
+		os.Exit(2)
+	}
+	logger := log.With(log.NewStdLogger(os.Stdout), "ts", log.DefaultTimestamp)
+	c := config.New(
+		config.WithSource(
+			file.NewSource(flagconf),
+		),
+	)
+	defer c.Close()
+
+	if err := c.Load(); err != nil {
+		panic(err)
+	}
+
+	var bc conf.Bootstrap
+	if err := c.Scan(&bc); err != nil {
+		panic(err)
+	}
+
+	router, err := data.NewShardRouter(bc.Data)
+	if err != nil {
+		panic(err)
+	}
+	d, cleanup, err := data.NewData(bc.Data, router, logger)
+	if err != nil {
+		panic(err)
+	}
+	defer cleanup()
+	uc := biz.NewReshardUsecase(data.NewReshardRepo(d, logger), int(bc.Data.GetReshard().GetBatchSize()), logger)
+
+	// 中断后可重新执行同一命令, 从上次的断点继续
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	var job *biz.ReshardJob
+	switch cmd := flag.Arg(0); cmd {
+	case "status":
+		job, err = uc.Status(ctx)
+	case "start":
+		job, err = uc.Start(ctx)
+	case "backfill":
+		job, err = uc.Backfill(ctx)
+	case "verify":
+		job, err = uc.Verify(ctx)
+	case "cutover":
+		job, err = uc.Cutover(ctx)
+	case "finish":
+		job, err = uc.Finish(ctx)
+	case "abort":
+		job, err = uc.Abort(ctx)
+	default:
+		flag.Usage()
+		os.Exit(2)
+	}
+	if job != nil {
+		printJob(job)
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "error:", err)
+		os.Exit(1)
+	}
+}
+
+func printJob(job *biz.ReshardJob) {
+	fmt.Printf("job:        %d\n", job.ID)
+	fmt.Printf("topology:   %s -> %s\n", job.SourceVersion, job.TargetVersion)
+	fmt.Printf("phase:      %s (finished: %t, since %s)\n", job.Phase, job.PhaseDone, job.PhaseChangedAt.Format("2006-01-02 15:04:05"))
+	fmt.Printf("checkpoint: %d\n", job.LastID)
+	if job.Total > 0 {
+		fmt.Printf("copied:     %d (index rows %d)\n", job.Copied, job.Total)
+	}
+	fmt.Printf("verified:   %d, mismatched: %d\n", job.Verified, job.Mismatched)
+	fmt.Printf("cleaned:    %d\n", job.Cleaned)
+}
@@ -0,0 +1,76 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+
+	"content_manage/internal/biz"
+	"content_manage/internal/conf"
+	"content_manage/internal/data"
+
+	"github.com/go-kratos/kratos/v2/config"
+	"github.com/go-kratos/kratos/v2/config/file"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// flagconf is the config flag.
+var flagconf string
+
+func init() {
+	flag.StringVar(&flagconf, "conf", "../../configs", "config path, eg: -conf config.yaml")
+}
+
+// 从索引表和所有详情表重建全文索引快照, 运行中的实例会在下一个写入周期加载新快照
+func main() {
+	flag.Parse()
+	logger := log.With(log.NewStdLogger(os.Stdout), "ts", log.DefaultTimestamp)
+	c := config.New(
+		config.WithSource(
+			file.NewSource(flagconf),
+		),
+	)
+	defer c.Close()
+
+	if err := c.Load(); err != nil {
+		panic(err)
+	}
+
+	var bc conf.Bootstrap
+	if err := c.Scan(&bc); err != nil {
+		panic(err)
+	}
+	if bc.Data.GetSearch().GetPath() == "" {
+		fmt.Fprintln(os.Stderr, "error: data.search.path is not configured")
+		os.Exit(1)
+	}
+
+	router, err := data.NewShardRouter(bc.Data)
+	if err != nil {
+		panic(err)
+	}
+	d, cleanup, err := data.NewData(bc.Data, router, logger)
+	if err != nil {
+		panic(err)
+	}
+	defer cleanup()
+	search, cleanupSearch, err := data.NewSearchRepo(bc.Data, logger)
+	if err != nil {
+		panic(err)
+	}
+	defer cleanupSearch()
+	// 重建索引不会修改内容, 不需要幂等记录、待投递消息和事件
+	uc := biz.NewContentUsecase(data.NewContentRepo(bc.Data, d, logger), search, data.NewRevisionRepo(d, logger), nil, nil, nil, nil, logger)
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	indexed, err := uc.RebuildSearchIndex(ctx)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "error:", err)
+		os.Exit(1)
+	}
+	fmt.Printf("indexed %d contents into %s\n", indexed, bc.Data.GetSearch().GetPath())
+}
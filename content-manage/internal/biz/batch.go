@@ -0,0 +1,240 @@
+package biz
+
+import (
+	"content_manage/api/operate"
+	"context"
+)
+
+// maxBatchSize limits the number of items in one batch request.
+const maxBatchSize = 500
+
+var (
+	// ErrInvalidBatchSize is returned when a batch has no item or more than maxBatchSize items.
+	ErrInvalidBatchSize = operate.ErrorInvalidContent("批量数量需在 1 到 %d 之间", maxBatchSize)
+	// ErrBatchAborted is set on the items rolled back because another item of an atomic batch failed.
+	ErrBatchAborted = operate.ErrorContentConflict("同批次的其他内容失败, 已回滚")
+	// ErrInvalidContent is returned when a required field of a new Content is empty.
+	ErrInvalidContent = operate.ErrorInvalidContent("标题、视频地址和作者不能为空")
+)
+
+// BatchResult is the outcome of one item of a batch, results keep the order of the request.
+type BatchResult struct {
+	Content *Content
+	Err     error
+}
+
+func checkBatchSize(n int) error {
+	if n == 0 || n > maxBatchSize {
+		return ErrInvalidBatchSize
+	}
+	return nil
+}
+
+// checkContent checks the required fields of a new Content.
+func checkContent(c *Content) error {
+	if c.Title == "" || c.VideoURL == "" || c.Author == "" {
+		return ErrInvalidContent
+	}
+	return nil
+}
+
+// abortOthers marks the items that did not fail as aborted after an atomic batch failed.
+func abortOthers(results []*BatchResult) {
+	for _, r := range results {
+		if r.Err == nil {
+			r.Content, r.Err = nil, ErrBatchAborted
+		}
+	}
+}
+
+// BatchCreateContent creates Contents with one multi-row insert per table.
+// Without atomic each item fails on its own, with atomic nothing is written when any item fails.
+func (uc *ContentUsecase) BatchCreateContent(ctx context.Context, contents []*Content, atomic bool) ([]*BatchResult, error) {
+	uc.log.WithContext(ctx).Infof("BatchCreateContent: %d contents, atomic = %v", len(contents), atomic)
+	if err := checkBatchSize(len(contents)); err != nil {
+		return nil, err
+	}
+	results := make([]*BatchResult, len(contents))
+	// valid 通过校验的内容, pos 为其在请求中的位置
+	var valid []*Content
+	var pos []int
+	for i, c := range contents {
+		results[i] = &BatchResult{Err: checkContent(c)}
+		if results[i].Err == nil {
+			valid = append(valid, c)
+			pos = append(pos, i)
+		}
+	}
+	if atomic && len(valid) < len(contents) {
+		abortOthers(results)
+		return results, nil
+	}
+	if len(valid) == 0 {
+		return results, nil
+	}
+
+	ids, err := uc.batchCreate(ctx, valid)
+	if err != nil && atomic {
+		for _, r := range results {
+			r.Err = err
+		}
+		return results, nil
+	}
+	if err != nil {
+		// 整批写入失败时逐条写入, 找出失败的内容
+		uc.log.WithContext(ctx).Warnf("BatchCreateContent: batch insert error = %v, retrying one by one", err)
+		ids = make([]int64, len(valid))
+		for i, c := range valid {
+			if ids[i], err = uc.create(ctx, c); err != nil {
+				results[pos[i]].Err = err
+			}
+		}
+	}
+
+	created := make([]int64, 0, len(ids))
+	for _, id := range ids {
+		if id != 0 {
+			created = append(created, id)
+		}
+	}
+	// 读回刚提交的内容, 从主库读取
+	stored, err := uc.BatchGetContent(WithPrimary(ctx), created)
+	if err != nil {
+		return nil, err
+	}
+	for i, r := range stored {
+		results[pos[indexOf(ids, created[i])]] = r
+		if r.Content == nil {
+			continue
+		}
+		if err := uc.search.Index(ctx, r.Content); err != nil {
+			uc.log.WithContext(ctx).Errorf("index content %d error = %v", r.Content.ID, err)
+		}
+	}
+	return results, nil
+}
+
+// batchCreate inserts contents and queues their content-flow triggers and events in one transaction.
+func (uc *ContentUsecase) batchCreate(ctx context.Context, contents []*Content) ([]int64, error) {
+	for _, c := range contents {
+		if err := uc.assignKey(ctx, c); err != nil {
+			return nil, err
+		}
+	}
+	var ids []int64
+	err := uc.repo.ExecTx(ctx, func(ctx context.Context) error {
+		var err error
+		if ids, err = uc.repo.BatchCreate(ctx, contents); err != nil {
+			return err
+		}
+		msgs, err := flowMessages(contents...)
+		if err != nil {
+			return err
+		}
+		if err := uc.outbox.Add(ctx, msgs...); err != nil {
+			return err
+		}
+		created, err := uc.BatchGetContent(WithPrimary(ctx), ids)
+		if err != nil {
+			return err
+		}
+		events := make([]*ContentEvent, 0, len(created))
+		for _, r := range created {
+			if r.Content != nil {
+				events = append(events, newEvent(EventContentCreated, r.Content))
+			}
+		}
+		return uc.emit(ctx, events...)
+	})
+	return ids, err
+}
+
+func indexOf(ids []int64, id int64) int {
+	for i, v := range ids {
+		if v == id {
+			return i
+		}
+	}
+	return -1
+}
+
+// BatchGetContent returns Contents by IDs with one query per shard, a missing ID gets ErrContentNotFound.
+func (uc *ContentUsecase) BatchGetContent(ctx context.Context, ids []int64) ([]*BatchResult, error) {
+	uc.log.WithContext(ctx).Infof("BatchGetContent: %v", ids)
+	if len(ids) > maxBatchSize {
+		return nil, ErrInvalidBatchSize
+	}
+	results := make([]*BatchResult, len(ids))
+	if len(ids) == 0 {
+		return results, nil
+	}
+	indices, _, err := uc.repo.FindIndex(ctx, &FindParams{IDs: ids, PageSize: int32(len(ids)), SkipTotal: true})
+	if err != nil {
+		return nil, err
+	}
+	contents, err := uc.repo.FindByContentIDs(ctx, indices)
+	unavailable, err := partialRead(err)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[int64]*Content, len(contents))
+	for _, c := range contents {
+		byID[c.ID] = c
+	}
+	// 所在分片库不可用的条目单独返回 SHARD_UNAVAILABLE, 不影响其余条目
+	shardDown := make(map[int64]bool, len(unavailable))
+	for _, idx := range indices {
+		if unavailable[idx.ContentID] {
+			shardDown[idx.ID] = true
+		}
+	}
+	for i, id := range ids {
+		if c, ok := byID[id]; ok {
+			results[i] = &BatchResult{Content: c}
+		} else if shardDown[id] {
+			results[i] = &BatchResult{Err: ErrShardUnavailable}
+		} else {
+			results[i] = &BatchResult{Err: ErrContentNotFound}
+		}
+	}
+	return results, nil
+}
+
+// BatchDeleteContent moves Contents to the trash.
+// Without atomic each item is deleted on its own, with atomic nothing is deleted when any item fails.
+func (uc *ContentUsecase) BatchDeleteContent(ctx context.Context, ids []int64, atomic bool) ([]*BatchResult, error) {
+	uc.log.WithContext(ctx).Infof("BatchDeleteContent: %v, atomic = %v", ids, atomic)
+	if err := checkBatchSize(len(ids)); err != nil {
+		return nil, err
+	}
+	results := make([]*BatchResult, len(ids))
+	for i := range ids {
+		results[i] = &BatchResult{}
+	}
+	if !atomic {
+		for i, id := range ids {
+			results[i].Err = uc.DeleteContent(ctx, id, 0)
+		}
+		return results, nil
+	}
+
+	err := uc.repo.ExecTx(ctx, func(ctx context.Context) error {
+		for i, id := range ids {
+			if err := uc.deleteContent(ctx, id, 0); err != nil {
+				results[i].Err = err
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		abortOthers(results)
+		return results, nil
+	}
+	for _, id := range ids {
+		if err := uc.search.Remove(ctx, id); err != nil {
+			uc.log.WithContext(ctx).Errorf("remove content %d from search index error = %v", id, err)
+		}
+	}
+	return results, nil
+}
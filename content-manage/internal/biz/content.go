@@ -1,12 +1,11 @@
 package biz
 
 import (
+	"content_manage/api/operate"
 	"context"
-	"errors"
 	"time"
 
 	"github.com/go-kratos/kratos/v2/log"
-	"golang.org/x/sync/errgroup"
 )
 
 // Content is a Content model.
@@ -25,96 +24,347 @@ type Content struct {
 	Format         string        `json:"format"`
 	Quality        int32         `json:"quality"`
 	ApprovalStatus int32         `json:"approval_status"`
+	Version        int64         `json:"version"`
 	UpdatedAt      time.Time     `json:"updated_at"`
 	CreatedAt      time.Time     `json:"created_at"`
+	// DeletedAt is set while the Content is in the trash.
+	DeletedAt time.Time `json:"deleted_at"`
 }
 
+// contentField is an editable field of a Content, named as in the API.
+type contentField struct {
+	name  string
+	value func(c *Content) interface{}
+	// required fields can not be updated to the zero value, see checkContent.
+	required bool
+}
+
+var contentFields = []contentField{
+	{"title", func(c *Content) interface{} { return c.Title }, true},
+	{"video_url", func(c *Content) interface{} { return c.VideoURL }, true},
+	{"author", func(c *Content) interface{} { return c.Author }, true},
+	{"description", func(c *Content) interface{} { return c.Description }, false},
+	{"thumbnail", func(c *Content) interface{} { return c.Thumbnail }, false},
+	{"category", func(c *Content) interface{} { return c.Category }, false},
+	{"duration", func(c *Content) interface{} { return c.Duration }, false},
+	{"resolution", func(c *Content) interface{} { return c.Resolution }, false},
+	{"file_size", func(c *Content) interface{} { return c.FileSize }, false},
+	{"format", func(c *Content) interface{} { return c.Format }, false},
+	{"quality", func(c *Content) interface{} { return c.Quality }, false},
+	{"approval_status", func(c *Content) interface{} { return c.ApprovalStatus }, false},
+}
+
+// ErrContentNotFound is returned when no Content matches the ID or content_id.
+var ErrContentNotFound = operate.ErrorContentNotFound("内容不存在")
+
+// ErrVersionConflict is returned when a Content was changed by someone else since the expected version.
+var ErrVersionConflict = operate.ErrorContentConflict("内容已被其他人修改, 请刷新后重试")
+
+// ErrInvalidUpdateMask is returned when an update names no field or an unknown field.
+var ErrInvalidUpdateMask = operate.ErrorInvalidContent("更新字段无效")
+
+// checkFields checks that fields is not empty, names editable fields only
+// and does not clear a required field of c.
+func checkFields(c *Content, fields []string) error {
+	if len(fields) == 0 {
+		return ErrInvalidUpdateMask.WithMetadata(map[string]string{"reason": "empty"})
+	}
+	for _, field := range fields {
+		var known *contentField
+		for i := range contentFields {
+			if contentFields[i].name == field {
+				known = &contentFields[i]
+				break
+			}
+		}
+		if known == nil {
+			return ErrInvalidUpdateMask.WithMetadata(map[string]string{"field": field})
+		}
+		if known.required && known.value(c) == "" {
+			return ErrInvalidContent.WithMetadata(map[string]string{"field": field})
+		}
+	}
+	return nil
+}
+
+// SortBy is the column FindContent sorts by.
+type SortBy int32
+
+const (
+	SortByCreatedAt SortBy = iota
+	SortByUpdatedAt
+	SortByDuration
+	SortByFileSize
+)
+
 type FindParams struct {
-	ID       int64
-	Author   string
-	Title    string
-	Page     int32
-	PageSize int32
+	ID             int64
+	IDs            []int64
+	ContentID      string
+	Author         string
+	Title          string
+	Category       string
+	ApprovalStatus []int32
+	Quality        int32
+	Format         string
+	Resolution     string
+	CreatedAfter   time.Time
+	CreatedBefore  time.Time
+	UpdatedAfter   time.Time
+	UpdatedBefore  time.Time
+	MinDuration    time.Duration
+	MaxDuration    time.Duration
+	SortBy         SortBy
+	Desc           bool
+	Page           int32
+	PageSize       int32
+	PageToken      string
+	SkipTotal      bool
+	// After is decoded from PageToken, rows after it are returned instead of paging by offset.
+	After *Cursor
 }
 
 type ContentIndex struct {
 	ID        int64
 	ContentID string
+	Duration  time.Duration
+	FileSize  int64
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// SortValue returns the value of the sort column, times are in unix nanoseconds.
+func (idx *ContentIndex) SortValue(sortBy SortBy) int64 {
+	switch sortBy {
+	case SortByUpdatedAt:
+		return idx.UpdatedAt.UnixNano()
+	case SortByDuration:
+		return int64(idx.Duration)
+	case SortByFileSize:
+		return idx.FileSize
+	}
+	return idx.CreatedAt.UnixNano()
 }
 
 // ContentRepo is a Content repo.
 type ContentRepo interface {
+	// ExecTx runs fn as one unit of work, repo calls made with the ctx passed to fn join it.
+	ExecTx(ctx context.Context, fn func(ctx context.Context) error) error
 	Create(ctx context.Context, c *Content) (int64, error)
+	// Update updates the non-zero fields of c.
 	Update(ctx context.Context, id int64, c *Content) error
+	// UpdateFields updates the named fields of c, including zero values.
+	UpdateFields(ctx context.Context, id int64, c *Content, fields []string) error
+	// BatchCreate inserts contents with one multi-row insert per table and returns their IDs in order.
+	BatchCreate(ctx context.Context, contents []*Content) ([]int64, error)
 	IsExist(ctx context.Context, id int64) (bool, error)
-	Delete(ctx context.Context, id int64) error
+	// Delete moves a Content to the trash, version 0 skips the version check.
+	Delete(ctx context.Context, id int64, version int64) error
 	Find(ctx context.Context, params *FindParams) ([]*Content, int64, error)
 	FindIndex(ctx context.Context, params *FindParams) ([]*ContentIndex, int64, error)
 	First(ctx context.Context, idx *ContentIndex) (*Content, error)
+	// FindByContentIDs fetches details with one query per shard, results keep the order of indices.
+	// Contents on unavailable shards are left out and reported by a *PartialError.
+	FindByContentIDs(ctx context.Context, indices []*ContentIndex) ([]*Content, error)
+	// FindDeleted lists the trash, most recently deleted first.
+	FindDeleted(ctx context.Context, page, pageSize int32) ([]*Content, int64, error)
+	// Restore moves a Content out of the trash.
+	Restore(ctx context.Context, id int64) error
+	// Purge permanently deletes a Content in the trash.
+	Purge(ctx context.Context, id int64) error
+	// PurgeDeletedBefore permanently deletes at most limit Contents deleted before the given time.
+	PurgeDeletedBefore(ctx context.Context, before time.Time, limit int) (int, error)
 }
 
 // ContentUsecase is a Content usecase.
 type ContentUsecase struct {
-	repo ContentRepo
-	log  *log.Helper
+	repo        ContentRepo
+	search      SearchRepo
+	revisions   RevisionRepo
+	idempotency IdempotencyRepo
+	outbox      OutboxRepo
+	events      EventBus
+	ids         IDGenerator
+	log         *log.Helper
 }
 
 // NewContentUsecase new a Content usecase.
-func NewContentUsecase(repo ContentRepo, logger log.Logger) *ContentUsecase {
-	return &ContentUsecase{repo: repo, log: log.NewHelper(logger)}
+func NewContentUsecase(repo ContentRepo, search SearchRepo, revisions RevisionRepo, idempotency IdempotencyRepo, outbox OutboxRepo, events EventBus, ids IDGenerator, logger log.Logger) *ContentUsecase {
+	return &ContentUsecase{
+		repo:        repo,
+		search:      search,
+		revisions:   revisions,
+		idempotency: idempotency,
+		outbox:      outbox,
+		events:      events,
+		ids:         ids,
+		log:         log.NewHelper(logger),
+	}
 }
 
-// CreateContent creates a Content, and returns the new Content.
-func (uc *ContentUsecase) CreateContent(ctx context.Context, c *Content) (int64, error) {
+// CreateContent creates a Content, and returns the ID of the new Content.
+// Its content-flow run is triggered by the outbox relay after the transaction commits.
+// With a non-empty idempotencyKey a retry of the same request returns the first ID with created false.
+func (uc *ContentUsecase) CreateContent(ctx context.Context, c *Content, idempotencyKey string) (id int64, created bool, err error) {
 	uc.log.WithContext(ctx).Infof("CreateContent: %v", c)
-	return uc.repo.Create(ctx, c)
+	if err := checkContent(c); err != nil {
+		return 0, false, err
+	}
+	if idempotencyKey == "" {
+		id, err = uc.create(ctx, c)
+		created = err == nil
+	} else {
+		id, created, err = uc.createOnce(ctx, c, idempotencyKey)
+	}
+	if err != nil {
+		return 0, false, err
+	}
+	if created {
+		uc.reindex(ctx, id)
+	}
+	return id, created, nil
+}
+
+// create creates c and queues its content-flow trigger and ContentCreated event in the same transaction.
+// The ID and content_id of c are assigned before the insert.
+func (uc *ContentUsecase) create(ctx context.Context, c *Content) (int64, error) {
+	if err := uc.assignKey(ctx, c); err != nil {
+		return 0, err
+	}
+	var id int64
+	err := uc.repo.ExecTx(ctx, func(ctx context.Context) error {
+		var err error
+		if id, err = uc.repo.Create(ctx, c); err != nil {
+			return err
+		}
+		msgs, err := flowMessages(c)
+		if err != nil {
+			return err
+		}
+		if err := uc.outbox.Add(ctx, msgs...); err != nil {
+			return err
+		}
+		created, err := uc.load(ctx, id)
+		if err != nil {
+			return err
+		}
+		return uc.emit(ctx, newEvent(EventContentCreated, created))
+	})
+	return id, err
 }
 
-// UpdateContent updates a Content, and returns the new Content.
-func (uc *ContentUsecase) UpdateContent(ctx context.Context, c *Content) error {
-	uc.log.WithContext(ctx).Infof("UpdateContent: %v", c)
-	return uc.repo.Update(ctx, c.ID, c)
+// GetContent returns a Content by ID, or by contentID when id is 0.
+func (uc *ContentUsecase) GetContent(ctx context.Context, id int64, contentID string) (*Content, error) {
+	uc.log.WithContext(ctx).Infof("GetContent: %d, content_id = %s", id, contentID)
+	if id == 0 && contentID == "" {
+		return nil, ErrContentNotFound
+	}
+	if id != 0 {
+		contentID = ""
+	}
+	return uc.first(ctx, &FindParams{ID: id, ContentID: contentID})
+}
+
+// first returns the first Content matching params.
+func (uc *ContentUsecase) first(ctx context.Context, params *FindParams) (*Content, error) {
+	params.PageSize = 1
+	params.SkipTotal = true
+	indices, _, err := uc.repo.FindIndex(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	if len(indices) == 0 {
+		return nil, ErrContentNotFound
+	}
+	return uc.repo.First(ctx, indices[0])
+}
+
+// UpdateContent updates a Content, records the change as a revision by editor and returns the updated Content.
+// When fields is nil only the non-zero fields of c are updated, otherwise exactly the listed fields are.
+// A non-zero c.Version must match the stored version, otherwise ErrVersionConflict is returned.
+func (uc *ContentUsecase) UpdateContent(ctx context.Context, c *Content, fields []string, editor string) (*Content, error) {
+	uc.log.WithContext(ctx).Infof("UpdateContent: %v, fields = %v", c, fields)
+	if fields != nil {
+		if err := checkFields(c, fields); err != nil {
+			return nil, err
+		}
+	}
+	_, err := uc.revise(ctx, c.ID, editor, func(ctx context.Context) error {
+		if fields == nil {
+			return uc.repo.Update(ctx, c.ID, c)
+		}
+		return uc.repo.UpdateFields(ctx, c.ID, c, fields)
+	})
+	if err != nil {
+		return nil, err
+	}
+	uc.reindex(ctx, c.ID)
+	return uc.load(ctx, c.ID)
 }
 
-// DeleteContent deletes a Content by ID.
-func (uc *ContentUsecase) DeleteContent(ctx context.Context, id int64) error {
+// DeleteContent moves a Content to the trash, failing with ErrVersionConflict unless version is 0 or current.
+func (uc *ContentUsecase) DeleteContent(ctx context.Context, id int64, version int64) error {
 	uc.log.WithContext(ctx).Infof("DeleteContent: %d", id)
-	repo := uc.repo
-	isExist, err := repo.IsExist(ctx, id)
+	err := uc.repo.ExecTx(ctx, func(ctx context.Context) error {
+		return uc.deleteContent(ctx, id, version)
+	})
 	if err != nil {
 		return err
 	}
-	if !isExist {
-		return errors.New("内容不存在")
+	if err := uc.search.Remove(ctx, id); err != nil {
+		uc.log.WithContext(ctx).Errorf("remove content %d from search index error = %v", id, err)
 	}
+	return nil
+}
 
-	return uc.repo.Delete(ctx, id)
+// deleteContent moves a Content to the trash and emits ContentDeleted in the transaction of ctx.
+func (uc *ContentUsecase) deleteContent(ctx context.Context, id int64, version int64) error {
+	c, err := uc.load(ctx, id)
+	if err != nil {
+		return err
+	}
+	if err := uc.repo.Delete(ctx, id, version); err != nil {
+		return err
+	}
+	return uc.emit(ctx, newEvent(EventContentDeleted, c))
 }
 
-// FindContent finds a Content by ID.
-func (uc *ContentUsecase) FindContent(ctx context.Context, params *FindParams) ([]*Content, int64, error) {
+// FindContent finds Contents by params, and returns the token of the next page.
+func (uc *ContentUsecase) FindContent(ctx context.Context, params *FindParams) ([]*Content, int64, string, error) {
 	uc.log.WithContext(ctx).Infof("FindContent: %v", params)
+	if params.PageSize <= 0 {
+		params.PageSize = defaultPageSize
+	}
+	if params.PageToken != "" {
+		after, err := DecodeCursor(params.PageToken, params.SortBy, params.Desc)
+		if err != nil {
+			return nil, 0, "", err
+		}
+		params.After = after
+	}
 	repo := uc.repo
 	indices, total, err := repo.FindIndex(ctx, params)
 	if err != nil {
-		return nil, 0, err
-	}
-	var eg errgroup.Group
-	contents := make([]*Content, len(indices))
-	for index, idx := range indices {
-		tempIndex := index
-		tempIdx := idx
-		eg.Go(func() error {
-			content, err := repo.First(ctx, tempIdx)
-			if err != nil {
-				return err
-			}
-			contents[tempIndex] = content
-			return nil
-		})
+		return nil, 0, "", err
+	}
+	contents, err := repo.FindByContentIDs(ctx, indices)
+	unavailable, err := partialRead(err)
+	if err != nil {
+		return nil, 0, "", err
+	}
+	if len(unavailable) > 0 {
+		// 分片库不可用时返回其余分片的内容, 翻页游标仍按索引计算
+		uc.log.WithContext(ctx).Warnf("FindContent: %d contents on unavailable shards skipped", len(unavailable))
 	}
-	if err := eg.Wait(); err != nil {
-		return nil, 0, err
+	var nextPageToken string
+	if len(indices) == int(params.PageSize) {
+		last := indices[len(indices)-1]
+		nextPageToken = (&Cursor{
+			SortBy: params.SortBy,
+			Desc:   params.Desc,
+			Value:  last.SortValue(params.SortBy),
+			ID:     last.ID,
+		}).Encode()
 	}
-	return contents, total, nil
+	return contents, total, nextPageToken, nil
 }
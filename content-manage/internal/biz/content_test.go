@@ -0,0 +1,38 @@
+package biz
+
+import (
+	"content_manage/api/operate"
+	"testing"
+)
+
+func TestCheckFields(t *testing.T) {
+	full := &Content{Title: "title", VideoURL: "https://example.com/v.mp4", Author: "author"}
+	tests := []struct {
+		name    string
+		content *Content
+		fields  []string
+		reason  func(error) bool
+	}{
+		{"empty mask", full, []string{}, operate.IsInvalidContent},
+		{"unknown field", full, []string{"id"}, operate.IsInvalidContent},
+		{"clear optional field", &Content{}, []string{"description", "category"}, nil},
+		{"set required fields", full, []string{"title", "video_url", "author"}, nil},
+		{"clear title", &Content{VideoURL: full.VideoURL, Author: full.Author}, []string{"title"}, operate.IsInvalidContent},
+		{"clear video_url", &Content{Title: full.Title}, []string{"title", "video_url"}, operate.IsInvalidContent},
+		{"clear author", &Content{}, []string{"author"}, operate.IsInvalidContent},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := checkFields(tt.content, tt.fields)
+			if tt.reason == nil {
+				if err != nil {
+					t.Fatalf("checkFields() error = %v, want nil", err)
+				}
+				return
+			}
+			if !tt.reason(err) {
+				t.Fatalf("checkFields() error = %v, want INVALID_CONTENT", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,50 @@
+package biz
+
+import (
+	"content_manage/api/operate"
+	"encoding/base64"
+	"encoding/json"
+)
+
+const defaultPageSize = 10
+
+// ErrInvalidPageToken the page token is malformed or belongs to another sort order.
+var ErrInvalidPageToken = operate.ErrorInvalidContent("invalid page token")
+
+// Cursor is the position of the last row of a page, ordered by (sort value, id).
+type Cursor struct {
+	SortBy SortBy
+	Desc   bool
+	// Value is the sort column of the last row, times are in unix nanoseconds.
+	Value int64
+	ID    int64
+}
+
+type cursorToken struct {
+	SortBy SortBy `json:"s"`
+	Desc   bool   `json:"d,omitempty"`
+	Value  int64  `json:"v"`
+	ID     int64  `json:"i"`
+}
+
+// Encode returns the opaque page token of the cursor.
+func (c *Cursor) Encode() string {
+	data, _ := json.Marshal(cursorToken{SortBy: c.SortBy, Desc: c.Desc, Value: c.Value, ID: c.ID})
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// DecodeCursor parses a page token returned by Encode, the token must match the requested order.
+func DecodeCursor(token string, sortBy SortBy, desc bool) (*Cursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, ErrInvalidPageToken
+	}
+	var t cursorToken
+	if err := json.Unmarshal(data, &t); err != nil || t.ID <= 0 {
+		return nil, ErrInvalidPageToken
+	}
+	if t.SortBy != sortBy || t.Desc != desc {
+		return nil, ErrInvalidPageToken
+	}
+	return &Cursor{SortBy: t.SortBy, Desc: t.Desc, Value: t.Value, ID: t.ID}, nil
+}
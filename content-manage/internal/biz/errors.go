@@ -0,0 +1,64 @@
+package biz
+
+import (
+	"content_manage/api/operate"
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"net"
+
+	kerrors "github.com/go-kratos/kratos/v2/errors"
+)
+
+// ErrShardUnavailable is returned when the database shard holding a Content cannot be reached.
+var ErrShardUnavailable = operate.ErrorShardUnavailable("内容存储暂不可用, 请稍后重试")
+
+// MapError maps an error returned by the usecase to an ErrorReason.
+// Errors that already carry a reason are returned as they are, lost database connections
+// become ErrShardUnavailable and anything else is left to the transport as an unknown error.
+// A canceled or timed out request says nothing about the database, context.DeadlineExceeded
+// also implements net.Error, so context errors are checked first and returned as they are.
+func MapError(err error) error {
+	if err == nil || kerrors.Reason(err) != "" {
+		return err
+	}
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return err
+	}
+	var netErr net.Error
+	if errors.Is(err, driver.ErrBadConn) || errors.Is(err, sql.ErrConnDone) || errors.As(err, &netErr) {
+		return ErrShardUnavailable.WithCause(err)
+	}
+	return err
+}
+
+// PartialError is returned by ContentRepo.FindByContentIDs together with the Contents it could read
+// when some shard databases are unavailable, so that one bad shard does not fail the whole read.
+type PartialError struct {
+	// ContentIDs are the content_ids left out because their shard is unavailable.
+	ContentIDs []string
+	Err        error
+}
+
+func (e *PartialError) Error() string {
+	return fmt.Sprintf("%d contents unavailable: %v", len(e.ContentIDs), e.Err)
+}
+
+func (e *PartialError) Unwrap() error {
+	return e.Err
+}
+
+// partialRead separates a PartialError from other errors, returning the unavailable content_ids as a set.
+func partialRead(err error) (map[string]bool, error) {
+	var partial *PartialError
+	if !errors.As(err, &partial) {
+		return nil, err
+	}
+	unavailable := make(map[string]bool, len(partial.ContentIDs))
+	for _, contentID := range partial.ContentIDs {
+		unavailable[contentID] = true
+	}
+	return unavailable, nil
+}
@@ -0,0 +1,75 @@
+package biz
+
+import (
+	"content_manage/api/operate"
+	"context"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"net"
+	"testing"
+
+	kerrors "github.com/go-kratos/kratos/v2/errors"
+)
+
+func TestMapError(t *testing.T) {
+	netErr := &net.OpError{Op: "dial", Net: "tcp", Err: errors.New("connection refused")}
+	tests := []struct {
+		name string
+		err  error
+		// reason 为空时原样返回
+		reason operate.ErrorReason
+		code   int32
+	}{
+		{"nil", nil, 0, 0},
+		{"deadline exceeded", context.DeadlineExceeded, 0, 0},
+		{"wrapped deadline exceeded", fmt.Errorf("query: %w", context.DeadlineExceeded), 0, 0},
+		{"canceled", context.Canceled, 0, 0},
+		{"other error", errors.New("syntax error"), 0, 0},
+		{"bad connection", driver.ErrBadConn, operate.ErrorReason_SHARD_UNAVAILABLE, 503},
+		{"network error", fmt.Errorf("query: %w", netErr), operate.ErrorReason_SHARD_UNAVAILABLE, 503},
+		{"unspecified", operate.ErrorErrorReasonUnspecified("internal"), operate.ErrorReason_ERROR_REASON_UNSPECIFIED, 500},
+		{"not found", ErrContentNotFound, operate.ErrorReason_CONTENT_NOT_FOUND, 404},
+		{"wrapped not found", fmt.Errorf("restore: %w", ErrContentNotFound), operate.ErrorReason_CONTENT_NOT_FOUND, 404},
+		{"version conflict", ErrVersionConflict, operate.ErrorReason_CONTENT_CONFLICT, 409},
+		{"batch aborted", ErrBatchAborted, operate.ErrorReason_CONTENT_CONFLICT, 409},
+		{"invalid content", ErrInvalidContent, operate.ErrorReason_INVALID_CONTENT, 400},
+		{"shard unavailable", ErrShardUnavailable, operate.ErrorReason_SHARD_UNAVAILABLE, 503},
+		{"watch too slow", ErrWatchTooSlow, operate.ErrorReason_WATCH_TOO_SLOW, 429},
+		{"id unavailable", ErrIDUnavailable, operate.ErrorReason_ID_UNAVAILABLE, 503},
+	}
+	covered := make(map[operate.ErrorReason]bool)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := MapError(tt.err)
+			if tt.code == 0 {
+				if got != tt.err {
+					t.Fatalf("MapError(%v) = %v, want the error unchanged", tt.err, got)
+				}
+				return
+			}
+			covered[tt.reason] = true
+			e := kerrors.FromError(got)
+			if e.Reason != tt.reason.String() || e.Code != tt.code {
+				t.Fatalf("MapError(%v) = %s/%d, want %s/%d", tt.err, e.Reason, e.Code, tt.reason, tt.code)
+			}
+		})
+	}
+	for value, name := range operate.ErrorReason_name {
+		if !covered[operate.ErrorReason(value)] {
+			t.Fatalf("ErrorReason %s is not covered", name)
+		}
+	}
+}
+
+func TestErrWatchTooSlow(t *testing.T) {
+	if !operate.IsWatchTooSlow(ErrWatchTooSlow) {
+		t.Fatalf("ErrWatchTooSlow = %v, want reason WATCH_TOO_SLOW", ErrWatchTooSlow)
+	}
+}
+
+func TestErrIDUnavailable(t *testing.T) {
+	if !operate.IsIdUnavailable(ErrIDUnavailable) || operate.IsShardUnavailable(ErrIDUnavailable) {
+		t.Fatalf("ErrIDUnavailable = %v, want reason ID_UNAVAILABLE", ErrIDUnavailable)
+	}
+}
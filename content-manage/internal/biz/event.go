@@ -0,0 +1,94 @@
+package biz
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// TopicContentEvent publishes a ContentEvent through the EventBus.
+const TopicContentEvent = "content.event"
+
+// EventType is the type of a ContentEvent.
+type EventType string
+
+const (
+	EventContentCreated  EventType = "ContentCreated"
+	EventContentUpdated  EventType = "ContentUpdated"
+	EventContentDeleted  EventType = "ContentDeleted"
+	EventApprovalChanged EventType = "ApprovalChanged"
+)
+
+// Offsets accepted by EventBus.Subscribe and EventBus.Seek besides the offset of an event.
+const (
+	// OffsetOldest starts from the oldest retained event.
+	OffsetOldest = "0"
+	// OffsetLatest starts from the events published after subscribing.
+	OffsetLatest = "$"
+)
+
+// ContentEvent is a change of a Content.
+type ContentEvent struct {
+	ID         string    `json:"id"`
+	Type       EventType `json:"type"`
+	OccurredAt time.Time `json:"occurred_at"`
+	// Content is the Content after the change, or before it for ContentDeleted.
+	Content *Content `json:"content"`
+	// ChangedFields and Editor are set for ContentUpdated.
+	ChangedFields []string `json:"changed_fields,omitempty"`
+	Editor        string   `json:"editor,omitempty"`
+	// ApprovalFrom is the approval status before an ApprovalChanged.
+	ApprovalFrom int32 `json:"approval_from,omitempty"`
+	// Offset is the position in the stream, set when the event is delivered to a subscriber.
+	Offset string `json:"-"`
+}
+
+// EventHandler handles a delivered event, an error leaves the event pending and it is delivered again.
+type EventHandler func(ctx context.Context, e *ContentEvent) error
+
+// EventBus publishes ContentEvents to a stream read by consumer groups.
+type EventBus interface {
+	Publish(ctx context.Context, events ...*ContentEvent) error
+	// Subscribe delivers events to handler as a consumer of group until ctx is done.
+	// Consumers of a group share the events, each group receives every event.
+	// A new group starts from the offset from, an existing group resumes where it left off.
+	Subscribe(ctx context.Context, group, consumer, from string, handler EventHandler) error
+	// Seek moves a group to offset, so the events after it are delivered again.
+	Seek(ctx context.Context, group, offset string) error
+	// Tail delivers every event after the offset from to handler until ctx is done, without a consumer group.
+	// It stops and returns the error of handler.
+	Tail(ctx context.Context, from string, handler EventHandler) error
+}
+
+func newEvent(t EventType, c *Content) *ContentEvent {
+	return &ContentEvent{ID: uuid.New().String(), Type: t, OccurredAt: time.Now(), Content: c}
+}
+
+// emit writes events to the outbox in the transaction of ctx, they are published after it commits.
+func (uc *ContentUsecase) emit(ctx context.Context, events ...*ContentEvent) error {
+	msgs := make([]*OutboxMessage, 0, len(events))
+	for _, e := range events {
+		payload, err := json.Marshal(e)
+		if err != nil {
+			return err
+		}
+		msgs = append(msgs, &OutboxMessage{Topic: TopicContentEvent, ContentID: e.Content.ContentID, Payload: payload})
+	}
+	return uc.outbox.Add(ctx, msgs...)
+}
+
+// updateEvents returns the events of an update from before to after.
+func updateEvents(before, after *Content, changed []string, editor string) []*ContentEvent {
+	updated := newEvent(EventContentUpdated, after)
+	updated.ChangedFields = changed
+	updated.Editor = editor
+	events := []*ContentEvent{updated}
+	if before.ApprovalStatus != after.ApprovalStatus {
+		approval := newEvent(EventApprovalChanged, after)
+		approval.ApprovalFrom = before.ApprovalStatus
+		events = append(events, approval)
+	}
+	return events
+}
@@ -0,0 +1,23 @@
+package biz
+
+import "testing"
+
+func TestUpdateEventsApproval(t *testing.T) {
+	before := &Content{ID: 1, ApprovalStatus: 1}
+	after := &Content{ID: 1, ApprovalStatus: 2}
+	// content-flow 通过 UpdateContent 修改审核状态, 与其他修改一样发布事件
+	events := updateEvents(before, after, []string{"approval_status"}, "content-flow")
+	if len(events) != 2 {
+		t.Fatalf("updateEvents() = %d events, want 2", len(events))
+	}
+	if events[0].Type != EventContentUpdated || events[0].Editor != "content-flow" {
+		t.Fatalf("events[0] = %+v, want ContentUpdated by content-flow", events[0])
+	}
+	if events[1].Type != EventApprovalChanged || events[1].ApprovalFrom != 1 || events[1].Content.ApprovalStatus != 2 {
+		t.Fatalf("events[1] = %+v, want ApprovalChanged from 1 to 2", events[1])
+	}
+
+	if events := updateEvents(before, &Content{ID: 1, ApprovalStatus: 1, Title: "t"}, []string{"title"}, ""); len(events) != 1 {
+		t.Fatalf("updateEvents() = %d events without an approval change, want 1", len(events))
+	}
+}
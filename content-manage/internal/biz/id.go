@@ -0,0 +1,44 @@
+package biz
+
+import (
+	"content_manage/api/operate"
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// ErrIDUnavailable is returned when no Content ID can be issued, e.g. the worker ID lease is lost
+// or the clock moved backwards too far.
+var ErrIDUnavailable = operate.ErrorIdUnavailable("暂时无法分配内容 id, 请稍后重试")
+
+// IDGenerator issues unique Content IDs across databases, roughly ordered by time.
+type IDGenerator interface {
+	NextID(ctx context.Context) (int64, error)
+}
+
+// NewContentKey returns the ID and the content_id shard key of a new Content before anything is written.
+func (uc *ContentUsecase) NewContentKey(ctx context.Context) (int64, string, error) {
+	id, err := uc.ids.NextID(ctx)
+	if err != nil {
+		return 0, "", err
+	}
+	return id, uuid.New().String(), nil
+}
+
+// assignKey gives c an ID and content_id unless it already has them, e.g. from a rolled back batch.
+func (uc *ContentUsecase) assignKey(ctx context.Context, c *Content) error {
+	if c.ID != 0 && c.ContentID != "" {
+		return nil
+	}
+	id, contentID, err := uc.NewContentKey(ctx)
+	if err != nil {
+		return err
+	}
+	if c.ID == 0 {
+		c.ID = id
+	}
+	if c.ContentID == "" {
+		c.ContentID = contentID
+	}
+	return nil
+}
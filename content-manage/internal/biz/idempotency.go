@@ -0,0 +1,100 @@
+package biz
+
+import (
+	"content_manage/api/operate"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+)
+
+// maxIdempotencyKeyLen is the length of the idempotency_key column.
+const maxIdempotencyKeyLen = 128
+
+// ErrInvalidIdempotencyKey is returned when an idempotency key is longer than maxIdempotencyKeyLen.
+var ErrInvalidIdempotencyKey = operate.ErrorInvalidContent("幂等键过长")
+
+// ErrIdempotencyKeyReused is returned when an idempotency key is retried with a different request.
+var ErrIdempotencyKeyReused = operate.ErrorContentConflict("幂等键已用于其他请求")
+
+// ErrIdempotencyKeyExists is returned by IdempotencyRepo.Save when a concurrent request saved the key first.
+var ErrIdempotencyKeyExists = errors.New("idempotency key exists")
+
+// IdempotencyRecord remembers the result of a create request until it expires.
+type IdempotencyRecord struct {
+	Key string
+	// Fingerprint identifies the request, a retry must carry the same request.
+	Fingerprint string
+	// ContentID is the ID of the created Content.
+	ContentID int64
+}
+
+// IdempotencyRepo is an IdempotencyRecord repo.
+type IdempotencyRepo interface {
+	// Get returns nil when the key is unused or has expired.
+	Get(ctx context.Context, key string) (*IdempotencyRecord, error)
+	// Save stores r with the configured TTL, it returns ErrIdempotencyKeyExists when the key is taken.
+	Save(ctx context.Context, r *IdempotencyRecord) error
+}
+
+// fingerprint hashes the fields given by the client, ID and ContentID are generated per request and left out.
+func fingerprint(c *Content) (string, error) {
+	req := *c
+	req.ID = 0
+	req.ContentID = ""
+	data, err := json.Marshal(&req)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// createOnce creates c at most once per key, a retry returns the ID of the first Content.
+func (uc *ContentUsecase) createOnce(ctx context.Context, c *Content, key string) (int64, bool, error) {
+	if len(key) > maxIdempotencyKeyLen {
+		return 0, false, ErrInvalidIdempotencyKey
+	}
+	fp, err := fingerprint(c)
+	if err != nil {
+		return 0, false, err
+	}
+	replay := func(r *IdempotencyRecord) (int64, bool, error) {
+		if r.Fingerprint != fp {
+			return 0, false, ErrIdempotencyKeyReused
+		}
+		uc.log.WithContext(ctx).Infof("CreateContent: replay idempotency key %s, id = %d", key, r.ContentID)
+		return r.ContentID, false, nil
+	}
+
+	var id int64
+	var existing *IdempotencyRecord
+	err = uc.repo.ExecTx(ctx, func(ctx context.Context) error {
+		r, err := uc.idempotency.Get(ctx, key)
+		if err != nil {
+			return err
+		}
+		if r != nil {
+			existing = r
+			return nil
+		}
+		if id, err = uc.create(ctx, c); err != nil {
+			return err
+		}
+		return uc.idempotency.Save(ctx, &IdempotencyRecord{Key: key, Fingerprint: fp, ContentID: id})
+	})
+	if errors.Is(err, ErrIdempotencyKeyExists) {
+		// 并发的重试先提交, 本次写入已回滚, 返回先提交的结果
+		if existing, err = uc.idempotency.Get(ctx, key); err == nil && existing == nil {
+			err = ErrIdempotencyKeyExists
+		}
+	}
+	if err != nil {
+		return 0, false, err
+	}
+	if existing != nil {
+		return replay(existing)
+	}
+	return id, true, nil
+}
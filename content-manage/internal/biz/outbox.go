@@ -0,0 +1,148 @@
+package biz
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// TopicContentFlow triggers the content-flow run of a new Content.
+const TopicContentFlow = "content.flow"
+
+const (
+	// outboxBaseBackoff is the delay before the first retry, doubled per attempt up to the max backoff.
+	outboxBaseBackoff = time.Second
+	// outboxLease is how long a claimed message stays invisible to other relays.
+	outboxLease = time.Minute
+)
+
+var errUnknownTopic = errors.New("unknown outbox topic")
+
+// OutboxMessage is a message written in the same transaction as the change it announces.
+type OutboxMessage struct {
+	ID    int64
+	Topic string
+	// ContentID orders the messages of a Content: a message is not claimed while an earlier one
+	// of the same Topic and ContentID is undelivered, including one waiting for a retry.
+	// Messages without a ContentID are not ordered.
+	ContentID string
+	Payload   []byte
+	Attempts  int32
+}
+
+// OutboxRepo is an OutboxMessage repo.
+type OutboxRepo interface {
+	// Add writes messages in the transaction of ctx.
+	Add(ctx context.Context, msgs ...*OutboxMessage) error
+	// Claim returns at most limit due messages in id order and hides them from other relays for lease.
+	// A message is skipped while an earlier message of the same Topic and ContentID is undelivered.
+	Claim(ctx context.Context, limit int, lease time.Duration) ([]*OutboxMessage, error)
+	// Delivered removes a delivered message.
+	Delivered(ctx context.Context, id int64) error
+	// Failed records a failed attempt and schedules the next one.
+	Failed(ctx context.Context, id int64, cause error, next time.Time) error
+	// Backlog returns the number of undelivered messages.
+	Backlog(ctx context.Context) (int64, error)
+}
+
+// FlowTrigger starts the content-flow run of a Content.
+type FlowTrigger interface {
+	Trigger(ctx context.Context, contentID string) error
+}
+
+// FlowPayload is the payload of a TopicContentFlow message.
+type FlowPayload struct {
+	ContentID string `json:"content_id"`
+}
+
+// flowMessages returns the flow triggers of new Contents.
+func flowMessages(contents ...*Content) ([]*OutboxMessage, error) {
+	msgs := make([]*OutboxMessage, 0, len(contents))
+	for _, c := range contents {
+		payload, err := json.Marshal(&FlowPayload{ContentID: c.ContentID})
+		if err != nil {
+			return nil, err
+		}
+		msgs = append(msgs, &OutboxMessage{Topic: TopicContentFlow, ContentID: c.ContentID, Payload: payload})
+	}
+	return msgs, nil
+}
+
+// OutboxUsecase delivers outbox messages.
+type OutboxUsecase struct {
+	repo   OutboxRepo
+	flow   FlowTrigger
+	events EventBus
+	log    *log.Helper
+}
+
+// NewOutboxUsecase new an Outbox usecase.
+func NewOutboxUsecase(repo OutboxRepo, flow FlowTrigger, events EventBus, logger log.Logger) *OutboxUsecase {
+	return &OutboxUsecase{repo: repo, flow: flow, events: events, log: log.NewHelper(logger)}
+}
+
+// Relay delivers at most limit due messages, a failed message is retried with exponential backoff.
+// Messages are delivered at least once, so a receiver may see a message again.
+func (uc *OutboxUsecase) Relay(ctx context.Context, limit int, maxBackoff time.Duration) (delivered, failed int, err error) {
+	msgs, err := uc.repo.Claim(ctx, limit, outboxLease)
+	if err != nil {
+		return 0, 0, err
+	}
+	for _, msg := range msgs {
+		if err := uc.deliver(ctx, msg); err != nil {
+			failed++
+			next := time.Now().Add(backoff(msg.Attempts, maxBackoff))
+			uc.log.WithContext(ctx).Warnf("Relay: message %d attempt %d error = %v, retry at %s", msg.ID, msg.Attempts+1, err, next.Format(time.RFC3339))
+			if err := uc.repo.Failed(ctx, msg.ID, err, next); err != nil {
+				return delivered, failed, err
+			}
+			continue
+		}
+		delivered++
+		if err := uc.repo.Delivered(ctx, msg.ID); err != nil {
+			return delivered, failed, err
+		}
+	}
+	return delivered, failed, nil
+}
+
+// Backlog returns the number of undelivered messages.
+func (uc *OutboxUsecase) Backlog(ctx context.Context) (int64, error) {
+	return uc.repo.Backlog(ctx)
+}
+
+func (uc *OutboxUsecase) deliver(ctx context.Context, msg *OutboxMessage) error {
+	switch msg.Topic {
+	case TopicContentFlow:
+		var payload FlowPayload
+		if err := json.Unmarshal(msg.Payload, &payload); err != nil {
+			return err
+		}
+		return uc.flow.Trigger(ctx, payload.ContentID)
+	case TopicContentEvent:
+		var e ContentEvent
+		if err := json.Unmarshal(msg.Payload, &e); err != nil {
+			return err
+		}
+		return uc.events.Publish(ctx, &e)
+	default:
+		// 未知的消息无法投递, 保留在表中等待处理
+		uc.log.WithContext(ctx).Errorf("Relay: message %d has unknown topic %s", msg.ID, msg.Topic)
+		return errUnknownTopic
+	}
+}
+
+// backoff returns the delay after the given number of failed attempts.
+func backoff(attempts int32, max time.Duration) time.Duration {
+	d := outboxBaseBackoff
+	for i := int32(0); i < attempts && d < max; i++ {
+		d *= 2
+	}
+	if d > max {
+		d = max
+	}
+	return d
+}
@@ -0,0 +1,101 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+type fakeOutboxRepo struct {
+	msgs      []*OutboxMessage
+	lease     time.Duration
+	delivered []int64
+	failed    map[int64]time.Time
+}
+
+func (r *fakeOutboxRepo) Add(ctx context.Context, msgs ...*OutboxMessage) error {
+	r.msgs = append(r.msgs, msgs...)
+	return nil
+}
+
+func (r *fakeOutboxRepo) Claim(ctx context.Context, limit int, lease time.Duration) ([]*OutboxMessage, error) {
+	r.lease = lease
+	if limit > len(r.msgs) {
+		limit = len(r.msgs)
+	}
+	return r.msgs[:limit], nil
+}
+
+func (r *fakeOutboxRepo) Delivered(ctx context.Context, id int64) error {
+	r.delivered = append(r.delivered, id)
+	return nil
+}
+
+func (r *fakeOutboxRepo) Failed(ctx context.Context, id int64, cause error, next time.Time) error {
+	r.failed[id] = next
+	return nil
+}
+
+func (r *fakeOutboxRepo) Backlog(ctx context.Context) (int64, error) {
+	return int64(len(r.msgs)), nil
+}
+
+type fakeFlowTrigger map[string]error
+
+func (f fakeFlowTrigger) Trigger(ctx context.Context, contentID string) error {
+	return f[contentID]
+}
+
+func TestBackoff(t *testing.T) {
+	for attempts, want := range map[int32]time.Duration{
+		0:  time.Second,
+		1:  2 * time.Second,
+		3:  8 * time.Second,
+		10: time.Minute,
+		99: time.Minute,
+	} {
+		if got := backoff(attempts, time.Minute); got != want {
+			t.Fatalf("backoff(%d) = %s, want %s", attempts, got, want)
+		}
+	}
+}
+
+func TestOutboxRelay(t *testing.T) {
+	ctx := context.Background()
+	ok, _ := flowMessages(&Content{ContentID: "ok"})
+	fail, _ := flowMessages(&Content{ContentID: "fail"})
+	ok[0].ID = 1
+	fail[0].ID, fail[0].Attempts = 2, 2
+	repo := &fakeOutboxRepo{
+		msgs:   []*OutboxMessage{ok[0], fail[0], {ID: 3, Topic: "unknown"}},
+		failed: make(map[int64]time.Time),
+	}
+	flow := fakeFlowTrigger{"fail": errors.New("flow unavailable")}
+	uc := NewOutboxUsecase(repo, flow, nil, log.NewStdLogger(io.Discard))
+
+	start := time.Now()
+	delivered, failed, err := uc.Relay(ctx, 10, time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if delivered != 1 || failed != 2 {
+		t.Fatalf("Relay() = %d delivered, %d failed, want 1 and 2", delivered, failed)
+	}
+	if repo.lease != outboxLease {
+		t.Fatalf("Claim() lease = %s, want %s", repo.lease, outboxLease)
+	}
+	if len(repo.delivered) != 1 || repo.delivered[0] != 1 {
+		t.Fatalf("delivered = %v, want [1]", repo.delivered)
+	}
+	// 已失败 2 次的消息再次失败后等待 4s 重试, 未知类型的消息保留在表中
+	if next := repo.failed[2].Sub(start); next < 4*time.Second || next > 5*time.Second {
+		t.Fatalf("message 2 retries after %s, want 4s", next)
+	}
+	if _, ok := repo.failed[3]; !ok {
+		t.Fatal("message 3 with an unknown topic not marked failed")
+	}
+}
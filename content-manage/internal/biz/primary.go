@@ -0,0 +1,17 @@
+package biz
+
+import "context"
+
+type contextPrimaryKey struct{}
+
+// WithPrimary marks ctx for read-your-writes lookups: repos serve its reads from the primary database
+// instead of a replica that may not have caught up with the caller's own writes yet.
+func WithPrimary(ctx context.Context) context.Context {
+	return context.WithValue(ctx, contextPrimaryKey{}, true)
+}
+
+// IsPrimary reports whether reads made with ctx must go to the primary database.
+func IsPrimary(ctx context.Context) bool {
+	primary, _ := ctx.Value(contextPrimaryKey{}).(bool)
+	return primary
+}
@@ -0,0 +1,274 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// ReshardPhase is the phase of an online resharding job.
+type ReshardPhase string
+
+const (
+	// ReshardPhaseDualWrite writes to both layouts, reads from the old one.
+	ReshardPhaseDualWrite ReshardPhase = "dual_write"
+	// ReshardPhaseBackfill copies existing rows into the new layout.
+	ReshardPhaseBackfill ReshardPhase = "backfill"
+	// ReshardPhaseVerify compares both layouts and repairs differences.
+	ReshardPhaseVerify ReshardPhase = "verify"
+	// ReshardPhaseCutover reads from the new layout, still writes to both.
+	ReshardPhaseCutover ReshardPhase = "cutover"
+	// ReshardPhaseDone reads and writes the new layout only.
+	ReshardPhaseDone ReshardPhase = "done"
+	// ReshardPhaseAborted falls back to the old layout.
+	ReshardPhaseAborted ReshardPhase = "aborted"
+)
+
+var (
+	// ErrNoReshardJob no resharding job is in progress.
+	ErrNoReshardJob = errors.New("no reshard job in progress")
+	// ErrReshardPhase the job is not in a phase that allows the operation.
+	ErrReshardPhase = errors.New("reshard job is in the wrong phase")
+	// ErrReshardGrace the previous phase has not been seen by every instance yet.
+	ErrReshardGrace = errors.New("reshard phase changed too recently")
+	// ErrReshardUnverified cutover requires a verify pass without mismatches.
+	ErrReshardUnverified = errors.New("reshard verify pass has not completed cleanly")
+)
+
+// ReshardJob is the persisted progress of a resharding job.
+type ReshardJob struct {
+	ID             int64
+	SourceVersion  string
+	TargetVersion  string
+	Phase          ReshardPhase
+	LastID         int64 // checkpoint of the current phase, in index table id order
+	Total          int64
+	Copied         int64
+	Verified       int64
+	Mismatched     int64
+	Cleaned        int64
+	PhaseDone      bool // the current phase has processed every row
+	PhaseChangedAt time.Time
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+// ReshardRepo is a resharding repo.
+type ReshardRepo interface {
+	// Current returns the latest job for the configured target layout, nil if none.
+	Current(ctx context.Context) (*ReshardJob, error)
+	// Start creates the target tables and a job in dual write phase.
+	Start(ctx context.Context) (*ReshardJob, error)
+	// Save persists the job progress.
+	Save(ctx context.Context, job *ReshardJob) error
+	// CountIndex counts the index rows to be processed.
+	CountIndex(ctx context.Context) (int64, error)
+	// Backfill copies the next batch after job.LastID, returns the last id processed and the rows copied.
+	Backfill(ctx context.Context, job *ReshardJob, batch int) (lastID int64, copied int, err error)
+	// Verify compares and repairs the next batch, returns the last id processed, rows checked and mismatched.
+	Verify(ctx context.Context, job *ReshardJob, batch int) (lastID int64, checked, mismatched int, err error)
+	// Cleanup removes rows left in old tables that are reused by the new layout.
+	Cleanup(ctx context.Context, job *ReshardJob, batch int) (lastID int64, cleaned int, err error)
+	// PollInterval is how often instances refresh the job phase.
+	PollInterval() time.Duration
+}
+
+// ReshardUsecase drives an online resharding job through its phases.
+type ReshardUsecase struct {
+	repo  ReshardRepo
+	batch int
+	log   *log.Helper
+}
+
+// NewReshardUsecase new a reshard usecase.
+func NewReshardUsecase(repo ReshardRepo, batch int, logger log.Logger) *ReshardUsecase {
+	if batch <= 0 {
+		batch = 500
+	}
+	return &ReshardUsecase{repo: repo, batch: batch, log: log.NewHelper(logger)}
+}
+
+// Status returns the current job.
+func (uc *ReshardUsecase) Status(ctx context.Context) (*ReshardJob, error) {
+	job, err := uc.repo.Current(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if job == nil {
+		return nil, ErrNoReshardJob
+	}
+	return job, nil
+}
+
+// Start starts dual writing to the target layout.
+func (uc *ReshardUsecase) Start(ctx context.Context) (*ReshardJob, error) {
+	job, err := uc.repo.Current(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if job != nil && job.Phase != ReshardPhaseAborted {
+		return nil, fmt.Errorf("%w: job %d is %s", ErrReshardPhase, job.ID, job.Phase)
+	}
+	job, err = uc.repo.Start(ctx)
+	if err != nil {
+		return nil, err
+	}
+	uc.log.WithContext(ctx).Infof("reshard job %d started, %s -> %s", job.ID, job.SourceVersion, job.TargetVersion)
+	return job, nil
+}
+
+// Backfill copies existing rows into the target layout, it resumes from the last checkpoint.
+func (uc *ReshardUsecase) Backfill(ctx context.Context) (*ReshardJob, error) {
+	job, err := uc.enter(ctx, ReshardPhaseBackfill, ReshardPhaseDualWrite)
+	if err != nil {
+		return nil, err
+	}
+	for !job.PhaseDone {
+		lastID, copied, err := uc.repo.Backfill(ctx, job, uc.batch)
+		if err != nil {
+			return job, err
+		}
+		job.Copied += int64(copied)
+		job.PhaseDone = lastID == job.LastID
+		job.LastID = lastID
+		if err := uc.repo.Save(ctx, job); err != nil {
+			return job, err
+		}
+		uc.log.WithContext(ctx).Infof("reshard job %d backfill: last_id = %d, copied = %d/%d", job.ID, job.LastID, job.Copied, job.Total)
+	}
+	return job, nil
+}
+
+// Verify compares both layouts and repairs differences, it resumes from the last checkpoint.
+func (uc *ReshardUsecase) Verify(ctx context.Context) (*ReshardJob, error) {
+	job, err := uc.repo.Current(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// 上一轮校验有不一致时重新校验一轮
+	if job != nil && job.Phase == ReshardPhaseVerify && job.PhaseDone && job.Mismatched > 0 {
+		job.LastID, job.Verified, job.Mismatched, job.PhaseDone = 0, 0, 0, false
+		if err := uc.repo.Save(ctx, job); err != nil {
+			return nil, err
+		}
+	}
+	job, err = uc.enter(ctx, ReshardPhaseVerify, ReshardPhaseBackfill)
+	if err != nil {
+		return nil, err
+	}
+	for !job.PhaseDone {
+		lastID, checked, mismatched, err := uc.repo.Verify(ctx, job, uc.batch)
+		if err != nil {
+			return job, err
+		}
+		job.Verified += int64(checked)
+		job.Mismatched += int64(mismatched)
+		job.PhaseDone = lastID == job.LastID
+		job.LastID = lastID
+		if err := uc.repo.Save(ctx, job); err != nil {
+			return job, err
+		}
+		uc.log.WithContext(ctx).Infof("reshard job %d verify: last_id = %d, verified = %d, mismatched = %d", job.ID, job.LastID, job.Verified, job.Mismatched)
+	}
+	return job, nil
+}
+
+// Cutover switches reads to the target layout.
+func (uc *ReshardUsecase) Cutover(ctx context.Context) (*ReshardJob, error) {
+	job, err := uc.Status(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if job.Phase == ReshardPhaseVerify && job.Mismatched > 0 {
+		return nil, ErrReshardUnverified
+	}
+	return uc.enter(ctx, ReshardPhaseCutover, ReshardPhaseVerify)
+}
+
+// Finish stops writing to the old layout and cleans up rows left in reused tables.
+func (uc *ReshardUsecase) Finish(ctx context.Context) (*ReshardJob, error) {
+	job, err := uc.enter(ctx, ReshardPhaseDone, ReshardPhaseCutover)
+	if err != nil {
+		return nil, err
+	}
+	for !job.PhaseDone {
+		lastID, cleaned, err := uc.repo.Cleanup(ctx, job, uc.batch)
+		if err != nil {
+			return job, err
+		}
+		job.Cleaned += int64(cleaned)
+		job.PhaseDone = lastID == job.LastID
+		job.LastID = lastID
+		if err := uc.repo.Save(ctx, job); err != nil {
+			return job, err
+		}
+	}
+	uc.log.WithContext(ctx).Infof("reshard job %d done, cleaned = %d", job.ID, job.Cleaned)
+	return job, nil
+}
+
+// Abort falls back to the old layout, allowed until the job is done.
+func (uc *ReshardUsecase) Abort(ctx context.Context) (*ReshardJob, error) {
+	job, err := uc.Status(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if job.Phase == ReshardPhaseDone || job.Phase == ReshardPhaseAborted {
+		return nil, fmt.Errorf("%w: job %d is %s", ErrReshardPhase, job.ID, job.Phase)
+	}
+	uc.transit(job, ReshardPhaseAborted)
+	if err := uc.repo.Save(ctx, job); err != nil {
+		return nil, err
+	}
+	uc.log.WithContext(ctx).Infof("reshard job %d aborted", job.ID)
+	return job, nil
+}
+
+// enter moves the job from prev into phase, or resumes it if it is already in phase.
+func (uc *ReshardUsecase) enter(ctx context.Context, phase, prev ReshardPhase) (*ReshardJob, error) {
+	job, err := uc.Status(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if job.Phase == phase {
+		return job, nil
+	}
+	if job.Phase != prev {
+		return nil, fmt.Errorf("%w: job %d is %s, want %s", ErrReshardPhase, job.ID, job.Phase, prev)
+	}
+	switch prev {
+	case ReshardPhaseBackfill, ReshardPhaseVerify:
+		// 上一个阶段必须处理完所有行
+		if !job.PhaseDone {
+			return nil, fmt.Errorf("%w: %s has not finished", ErrReshardPhase, prev)
+		}
+	default:
+		// 等待所有实例都刷新到上一个阶段, 避免有实例仍按旧的路由读写
+		if wait := 2*uc.repo.PollInterval() - time.Since(job.PhaseChangedAt); wait > 0 {
+			return nil, fmt.Errorf("%w: retry in %s", ErrReshardGrace, wait.Round(time.Second))
+		}
+	}
+	if phase == ReshardPhaseBackfill {
+		total, err := uc.repo.CountIndex(ctx)
+		if err != nil {
+			return nil, err
+		}
+		job.Total = total
+	}
+	uc.transit(job, phase)
+	if err := uc.repo.Save(ctx, job); err != nil {
+		return nil, err
+	}
+	uc.log.WithContext(ctx).Infof("reshard job %d enters %s", job.ID, phase)
+	return job, nil
+}
+
+func (uc *ReshardUsecase) transit(job *ReshardJob, phase ReshardPhase) {
+	job.Phase = phase
+	job.PhaseChangedAt = time.Now()
+	job.LastID = 0
+	job.PhaseDone = false
+}
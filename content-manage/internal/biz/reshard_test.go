@@ -0,0 +1,297 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// fakeReshardRepo 索引表 id 为 1..rows, 每批处理 id 在 (LastID, LastID+batch] 的行
+type fakeReshardRepo struct {
+	job        *ReshardJob
+	rows       int64
+	poll       time.Duration
+	mismatches []int // 每轮校验发现的不一致行数
+	passes     int
+	failAt     int64 // Backfill 处理到该 id 所在的批次时失败
+	saves      int
+}
+
+func (r *fakeReshardRepo) Current(ctx context.Context) (*ReshardJob, error) {
+	if r.job == nil {
+		return nil, nil
+	}
+	job := *r.job
+	return &job, nil
+}
+
+func (r *fakeReshardRepo) Start(ctx context.Context) (*ReshardJob, error) {
+	var id int64 = 1
+	if r.job != nil {
+		id = r.job.ID + 1
+	}
+	r.job = &ReshardJob{ID: id, SourceVersion: "mod-4", TargetVersion: "mod-8", Phase: ReshardPhaseDualWrite, PhaseChangedAt: time.Now()}
+	return r.Current(ctx)
+}
+
+func (r *fakeReshardRepo) Save(ctx context.Context, job *ReshardJob) error {
+	saved := *job
+	r.job = &saved
+	r.saves++
+	return nil
+}
+
+func (r *fakeReshardRepo) CountIndex(ctx context.Context) (int64, error) {
+	return r.rows, nil
+}
+
+func (r *fakeReshardRepo) next(job *ReshardJob, batch int) (int64, int) {
+	lastID := job.LastID + int64(batch)
+	if lastID > r.rows {
+		lastID = r.rows
+	}
+	return lastID, int(lastID - job.LastID)
+}
+
+func (r *fakeReshardRepo) Backfill(ctx context.Context, job *ReshardJob, batch int) (int64, int, error) {
+	lastID, n := r.next(job, batch)
+	if r.failAt > job.LastID && r.failAt <= lastID {
+		r.failAt = 0
+		return 0, 0, errors.New("backfill failed")
+	}
+	return lastID, n, nil
+}
+
+func (r *fakeReshardRepo) Verify(ctx context.Context, job *ReshardJob, batch int) (int64, int, int, error) {
+	lastID, n := r.next(job, batch)
+	mismatched := 0
+	// 每轮的不一致都计入第一批
+	if job.LastID == 0 {
+		if r.passes < len(r.mismatches) {
+			mismatched = r.mismatches[r.passes]
+		}
+		r.passes++
+	}
+	return lastID, n, mismatched, nil
+}
+
+func (r *fakeReshardRepo) Cleanup(ctx context.Context, job *ReshardJob, batch int) (int64, int, error) {
+	lastID, n := r.next(job, batch)
+	return lastID, n, nil
+}
+
+func (r *fakeReshardRepo) PollInterval() time.Duration {
+	return r.poll
+}
+
+func newTestReshard(repo *fakeReshardRepo) *ReshardUsecase {
+	return NewReshardUsecase(repo, 3, log.NewStdLogger(io.Discard))
+}
+
+func TestReshardPhases(t *testing.T) {
+	ctx := context.Background()
+	repo := &fakeReshardRepo{rows: 10}
+	uc := newTestReshard(repo)
+
+	if _, err := uc.Status(ctx); !errors.Is(err, ErrNoReshardJob) {
+		t.Fatalf("Status() error = %v, want ErrNoReshardJob", err)
+	}
+	job, err := uc.Start(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if job.Phase != ReshardPhaseDualWrite {
+		t.Fatalf("Start() phase = %s, want %s", job.Phase, ReshardPhaseDualWrite)
+	}
+	if _, err := uc.Start(ctx); !errors.Is(err, ErrReshardPhase) {
+		t.Fatalf("Start() twice error = %v, want ErrReshardPhase", err)
+	}
+	// 跳过阶段
+	if _, err := uc.Verify(ctx); !errors.Is(err, ErrReshardPhase) {
+		t.Fatalf("Verify() in dual_write error = %v, want ErrReshardPhase", err)
+	}
+	if _, err := uc.Finish(ctx); !errors.Is(err, ErrReshardPhase) {
+		t.Fatalf("Finish() in dual_write error = %v, want ErrReshardPhase", err)
+	}
+
+	job, err = uc.Backfill(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if job.Phase != ReshardPhaseBackfill || !job.PhaseDone || job.Total != 10 || job.Copied != 10 || job.LastID != 10 {
+		t.Fatalf("Backfill() job = %+v, want all 10 rows copied", job)
+	}
+	// 重复执行已完成的阶段不做任何事
+	saves := repo.saves
+	if _, err := uc.Backfill(ctx); err != nil || repo.saves != saves {
+		t.Fatalf("Backfill() again error = %v, saves = %d, want no progress", err, repo.saves-saves)
+	}
+	if _, err := uc.Cutover(ctx); !errors.Is(err, ErrReshardPhase) {
+		t.Fatalf("Cutover() in backfill error = %v, want ErrReshardPhase", err)
+	}
+
+	job, err = uc.Verify(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if job.Phase != ReshardPhaseVerify || !job.PhaseDone || job.Verified != 10 || job.Mismatched != 0 || job.LastID != 10 {
+		t.Fatalf("Verify() job = %+v, want all 10 rows verified", job)
+	}
+
+	job, err = uc.Cutover(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if job.Phase != ReshardPhaseCutover {
+		t.Fatalf("Cutover() phase = %s, want %s", job.Phase, ReshardPhaseCutover)
+	}
+
+	job, err = uc.Finish(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if job.Phase != ReshardPhaseDone || !job.PhaseDone || job.Cleaned != 10 {
+		t.Fatalf("Finish() job = %+v, want done with 10 rows cleaned", job)
+	}
+	if _, err := uc.Abort(ctx); !errors.Is(err, ErrReshardPhase) {
+		t.Fatalf("Abort() after done error = %v, want ErrReshardPhase", err)
+	}
+
+	// 同一目标布局的任务完成后不能再次开始
+	if _, err := uc.Start(ctx); !errors.Is(err, ErrReshardPhase) {
+		t.Fatalf("Start() after done error = %v, want ErrReshardPhase", err)
+	}
+}
+
+func TestReshardBackfillResume(t *testing.T) {
+	ctx := context.Background()
+	repo := &fakeReshardRepo{rows: 10, failAt: 5}
+	uc := newTestReshard(repo)
+	if _, err := uc.Start(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	// 失败前的批次已保存检查点
+	if _, err := uc.Backfill(ctx); err == nil {
+		t.Fatal("Backfill() error = nil, want the repo error")
+	}
+	if repo.job.LastID != 3 || repo.job.Copied != 3 || repo.job.PhaseDone {
+		t.Fatalf("checkpoint = %+v, want last_id 3", repo.job)
+	}
+	job, err := uc.Backfill(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if job.Copied != 10 || job.LastID != 10 || !job.PhaseDone {
+		t.Fatalf("Backfill() resumed job = %+v, want all 10 rows copied once", job)
+	}
+	if _, err := uc.Cutover(ctx); !errors.Is(err, ErrReshardPhase) {
+		t.Fatalf("Cutover() before verify error = %v, want ErrReshardPhase", err)
+	}
+}
+
+func TestReshardVerifyMismatch(t *testing.T) {
+	ctx := context.Background()
+	repo := &fakeReshardRepo{rows: 10, mismatches: []int{2}}
+	uc := newTestReshard(repo)
+	if _, err := uc.Start(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := uc.Backfill(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	job, err := uc.Verify(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if job.Mismatched != 2 {
+		t.Fatalf("Verify() mismatched = %d, want 2", job.Mismatched)
+	}
+	if _, err := uc.Cutover(ctx); !errors.Is(err, ErrReshardUnverified) {
+		t.Fatalf("Cutover() error = %v, want ErrReshardUnverified", err)
+	}
+
+	// 有不一致时重新校验一轮, 没有不一致才能切换
+	job, err = uc.Verify(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if job.Mismatched != 0 || job.Verified != 10 || !job.PhaseDone {
+		t.Fatalf("Verify() second pass job = %+v, want a clean pass", job)
+	}
+	if _, err := uc.Cutover(ctx); err != nil {
+		t.Fatalf("Cutover() error = %v", err)
+	}
+}
+
+func TestReshardGrace(t *testing.T) {
+	ctx := context.Background()
+	repo := &fakeReshardRepo{rows: 10, poll: time.Hour}
+	uc := newTestReshard(repo)
+	if _, err := uc.Start(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	// 所有实例刷新到双写之前不能开始回填
+	if _, err := uc.Backfill(ctx); !errors.Is(err, ErrReshardGrace) {
+		t.Fatalf("Backfill() error = %v, want ErrReshardGrace", err)
+	}
+	repo.job.PhaseChangedAt = time.Now().Add(-2 * time.Hour)
+	if _, err := uc.Backfill(ctx); err != nil {
+		t.Fatalf("Backfill() after the grace period error = %v", err)
+	}
+	if _, err := uc.Verify(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	// 所有实例切换读之前不能停止双写
+	if _, err := uc.Cutover(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := uc.Finish(ctx); !errors.Is(err, ErrReshardGrace) {
+		t.Fatalf("Finish() error = %v, want ErrReshardGrace", err)
+	}
+}
+
+func TestReshardAbort(t *testing.T) {
+	ctx := context.Background()
+	repo := &fakeReshardRepo{rows: 10}
+	uc := newTestReshard(repo)
+	if _, err := uc.Abort(ctx); !errors.Is(err, ErrNoReshardJob) {
+		t.Fatalf("Abort() without a job error = %v, want ErrNoReshardJob", err)
+	}
+	if _, err := uc.Start(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := uc.Backfill(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	job, err := uc.Abort(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if job.Phase != ReshardPhaseAborted || job.LastID != 0 || job.PhaseDone {
+		t.Fatalf("Abort() job = %+v, want aborted", job)
+	}
+	if _, err := uc.Abort(ctx); !errors.Is(err, ErrReshardPhase) {
+		t.Fatalf("Abort() twice error = %v, want ErrReshardPhase", err)
+	}
+	if _, err := uc.Verify(ctx); !errors.Is(err, ErrReshardPhase) {
+		t.Fatalf("Verify() after abort error = %v, want ErrReshardPhase", err)
+	}
+
+	// 中止后可以重新开始
+	job, err = uc.Start(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if job.ID != 2 || job.Phase != ReshardPhaseDualWrite {
+		t.Fatalf("Start() after abort job = %+v, want a new dual_write job", job)
+	}
+}
@@ -0,0 +1,174 @@
+package biz
+
+import (
+	"content_manage/api/operate"
+	"context"
+	"fmt"
+	"time"
+)
+
+// ErrRevisionNotFound is returned when a Content has no such revision.
+var ErrRevisionNotFound = operate.ErrorContentNotFound("内容版本不存在")
+
+// Revision is an immutable snapshot of a Content saved by every update.
+type Revision struct {
+	ContentID int64
+	// Revision starts at 1 and increases by one per update.
+	Revision      int32
+	Editor        string
+	ChangedFields []string
+	Content       *Content
+	CreatedAt     time.Time
+}
+
+// FieldDiff is a field that differs between two revisions.
+type FieldDiff struct {
+	Field string
+	From  string
+	To    string
+}
+
+// RevisionRepo is a Revision repo.
+type RevisionRepo interface {
+	Create(ctx context.Context, r *Revision) error
+	// Latest returns nil when the Content has no revision yet.
+	Latest(ctx context.Context, contentID int64) (*Revision, error)
+	// List returns revisions newest first, without the Content snapshots.
+	List(ctx context.Context, contentID int64, page, pageSize int32) ([]*Revision, int64, error)
+	Get(ctx context.Context, contentID int64, revision int32) (*Revision, error)
+}
+
+// diffContents returns the editable fields that differ between a and b.
+func diffContents(a, b *Content) []*FieldDiff {
+	var diffs []*FieldDiff
+	for _, f := range contentFields {
+		x, y := f.value(a), f.value(b)
+		if x != y {
+			diffs = append(diffs, &FieldDiff{Field: f.name, From: fmt.Sprint(x), To: fmt.Sprint(y)})
+		}
+	}
+	return diffs
+}
+
+func changedFields(diffs []*FieldDiff) []string {
+	fields := make([]string, 0, len(diffs))
+	for _, d := range diffs {
+		fields = append(fields, d.Field)
+	}
+	return fields
+}
+
+// load returns the stored Content by ID, read from the primary so that it reflects writes just made.
+func (uc *ContentUsecase) load(ctx context.Context, id int64) (*Content, error) {
+	return uc.first(WithPrimary(ctx), &FindParams{ID: id})
+}
+
+// revise runs update and records the result as a new revision with its events in the same transaction.
+// Contents created before revisions existed get their current state saved as the first revision.
+func (uc *ContentUsecase) revise(ctx context.Context, id int64, editor string, update func(ctx context.Context) error) (*Revision, error) {
+	var revision *Revision
+	err := uc.repo.ExecTx(ctx, func(ctx context.Context) error {
+		before, err := uc.load(ctx, id)
+		if err != nil {
+			return err
+		}
+		latest, err := uc.revisions.Latest(ctx, id)
+		if err != nil {
+			return err
+		}
+		if latest == nil {
+			latest = &Revision{ContentID: id, Revision: 1, Content: before, CreatedAt: before.UpdatedAt}
+			if err := uc.revisions.Create(ctx, latest); err != nil {
+				return err
+			}
+		}
+		if err := update(ctx); err != nil {
+			return err
+		}
+		after, err := uc.load(ctx, id)
+		if err != nil {
+			return err
+		}
+		diffs := diffContents(before, after)
+		if len(diffs) == 0 {
+			return nil
+		}
+		if err := uc.emit(ctx, updateEvents(before, after, changedFields(diffs), editor)...); err != nil {
+			return err
+		}
+		// 同一内容并发更新时版本号冲突, 后提交的事务失败
+		revision = &Revision{
+			ContentID:     id,
+			Revision:      latest.Revision + 1,
+			Editor:        editor,
+			ChangedFields: changedFields(diffs),
+			Content:       after,
+		}
+		return uc.revisions.Create(ctx, revision)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return revision, nil
+}
+
+// ListRevisions lists the revisions of a Content, newest first.
+func (uc *ContentUsecase) ListRevisions(ctx context.Context, id int64, page, pageSize int32) ([]*Revision, int64, error) {
+	uc.log.WithContext(ctx).Infof("ListRevisions: %d", id)
+	if page <= 0 {
+		page = 1
+	}
+	if pageSize <= 0 {
+		pageSize = defaultPageSize
+	}
+	return uc.revisions.List(ctx, id, page, pageSize)
+}
+
+// GetRevision returns a revision with its Content snapshot.
+func (uc *ContentUsecase) GetRevision(ctx context.Context, id int64, revision int32) (*Revision, error) {
+	uc.log.WithContext(ctx).Infof("GetRevision: %d@%d", id, revision)
+	return uc.revisions.Get(ctx, id, revision)
+}
+
+// DiffRevisions compares the snapshots of two revisions.
+func (uc *ContentUsecase) DiffRevisions(ctx context.Context, id int64, from, to int32) ([]*FieldDiff, error) {
+	uc.log.WithContext(ctx).Infof("DiffRevisions: %d@%d..%d", id, from, to)
+	a, err := uc.revisions.Get(ctx, id, from)
+	if err != nil {
+		return nil, err
+	}
+	b, err := uc.revisions.Get(ctx, id, to)
+	if err != nil {
+		return nil, err
+	}
+	return diffContents(a.Content, b.Content), nil
+}
+
+// RollbackContent restores the fields of a Content to a revision, recorded as a new revision.
+// It returns nil when the Content already matches the revision.
+func (uc *ContentUsecase) RollbackContent(ctx context.Context, id int64, revision int32, editor string) (*Revision, error) {
+	uc.log.WithContext(ctx).Infof("RollbackContent: %d@%d by %s", id, revision, editor)
+	target, err := uc.revisions.Get(ctx, id, revision)
+	if err != nil {
+		return nil, err
+	}
+	rev, err := uc.revise(ctx, id, editor, func(ctx context.Context) error {
+		current, err := uc.load(ctx, id)
+		if err != nil {
+			return err
+		}
+		fields := changedFields(diffContents(current, target.Content))
+		if len(fields) == 0 {
+			return nil
+		}
+		// 快照中的版本号已过期, 基于当前版本回滚
+		content := *target.Content
+		content.Version = current.Version
+		return uc.repo.UpdateFields(ctx, id, &content, fields)
+	})
+	if err != nil {
+		return nil, err
+	}
+	uc.reindex(ctx, id)
+	return rev, nil
+}
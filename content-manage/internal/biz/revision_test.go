@@ -0,0 +1,63 @@
+package biz
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestDiffContents(t *testing.T) {
+	base := Content{
+		ID:        1,
+		ContentID: "content-1",
+		Title:     "title",
+		VideoURL:  "https://example.com/v.mp4",
+		Author:    "author",
+		Duration:  time.Minute,
+		Version:   1,
+	}
+	tests := []struct {
+		name   string
+		change func(c *Content)
+		want   []*FieldDiff
+	}{
+		{"identical", func(c *Content) {}, nil},
+		// 版本号、时间等不可编辑的字段不参与比较
+		{"not editable", func(c *Content) {
+			c.Version = 5
+			c.UpdatedAt = time.Now()
+		}, nil},
+		{"one field", func(c *Content) { c.Title = "new" }, []*FieldDiff{{Field: "title", From: "title", To: "new"}}},
+		{"in field order", func(c *Content) {
+			c.ApprovalStatus = 2
+			c.Duration = 90 * time.Second
+			c.Description = "description"
+		}, []*FieldDiff{
+			{Field: "description", From: "", To: "description"},
+			{Field: "duration", From: "1m0s", To: "1m30s"},
+			{Field: "approval_status", From: "0", To: "2"},
+		}},
+		{"cleared", func(c *Content) { c.Author = "" }, []*FieldDiff{{Field: "author", From: "author", To: ""}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, b := base, base
+			tt.change(&b)
+			got := diffContents(&a, &b)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("diffContents() = %v, want %v", diffStrings(got), diffStrings(tt.want))
+			}
+			if fields := changedFields(got); len(fields) != len(tt.want) {
+				t.Fatalf("changedFields() = %v, want %d fields", fields, len(tt.want))
+			}
+		})
+	}
+}
+
+func diffStrings(diffs []*FieldDiff) []string {
+	s := make([]string, 0, len(diffs))
+	for _, d := range diffs {
+		s = append(s, d.Field+": "+d.From+" -> "+d.To)
+	}
+	return s
+}
@@ -0,0 +1,122 @@
+package biz
+
+import (
+	"context"
+)
+
+// rebuildBatchSize is the number of index rows read per batch when rebuilding the search index.
+const rebuildBatchSize = 500
+
+// SearchHit is a full-text search result.
+type SearchHit struct {
+	ID        int64
+	ContentID string
+	Score     float64
+	// TitleHighlight and DescriptionHighlight wrap matched terms in <em></em>,
+	// the description is cut to a snippet around the first match.
+	TitleHighlight       string
+	DescriptionHighlight string
+	Content              *Content
+}
+
+// SearchRepo is a full-text index over content titles and descriptions.
+type SearchRepo interface {
+	Index(ctx context.Context, c *Content) error
+	Remove(ctx context.Context, id int64) error
+	Search(ctx context.Context, query string, offset, limit int) ([]*SearchHit, int64, error)
+	// Reset drops every indexed document.
+	Reset(ctx context.Context) error
+	// Save persists the index so it survives restarts.
+	Save(ctx context.Context) error
+}
+
+// SearchContent ranks Contents matching query by relevance.
+func (uc *ContentUsecase) SearchContent(ctx context.Context, query string, page, pageSize int32) ([]*SearchHit, int64, error) {
+	uc.log.WithContext(ctx).Infof("SearchContent: %s", query)
+	if page <= 0 {
+		page = 1
+	}
+	if pageSize <= 0 {
+		pageSize = defaultPageSize
+	}
+	hits, total, err := uc.search.Search(ctx, query, int((page-1)*pageSize), int(pageSize))
+	if err != nil {
+		return nil, 0, err
+	}
+	indices := make([]*ContentIndex, 0, len(hits))
+	for _, hit := range hits {
+		indices = append(indices, &ContentIndex{ID: hit.ID, ContentID: hit.ContentID})
+	}
+	contents, err := uc.repo.FindByContentIDs(ctx, indices)
+	unavailable, err := partialRead(err)
+	if err != nil {
+		return nil, 0, err
+	}
+	if len(unavailable) > 0 {
+		uc.log.WithContext(ctx).Warnf("SearchContent: %d contents on unavailable shards skipped", len(unavailable))
+	}
+	byID := make(map[int64]*Content, len(contents))
+	for _, content := range contents {
+		byID[content.ID] = content
+	}
+	// 详情已被删除但索引尚未更新的结果直接丢弃
+	results := make([]*SearchHit, 0, len(hits))
+	for _, hit := range hits {
+		if hit.Content = byID[hit.ID]; hit.Content != nil {
+			results = append(results, hit)
+		}
+	}
+	return results, total, nil
+}
+
+// RebuildSearchIndex re-indexes every Content from all shards and saves the index.
+func (uc *ContentUsecase) RebuildSearchIndex(ctx context.Context) (int, error) {
+	if err := uc.search.Reset(ctx); err != nil {
+		return 0, err
+	}
+	params := &FindParams{PageSize: rebuildBatchSize, SkipTotal: true}
+	var indexed int
+	for {
+		indices, _, err := uc.repo.FindIndex(ctx, params)
+		if err != nil {
+			return indexed, err
+		}
+		contents, err := uc.repo.FindByContentIDs(ctx, indices)
+		if err != nil {
+			return indexed, err
+		}
+		for _, content := range contents {
+			if err := uc.search.Index(ctx, content); err != nil {
+				return indexed, err
+			}
+		}
+		indexed += len(contents)
+		uc.log.WithContext(ctx).Infof("RebuildSearchIndex: indexed %d", indexed)
+		if len(indices) < rebuildBatchSize {
+			break
+		}
+		last := indices[len(indices)-1]
+		params.After = &Cursor{Value: last.SortValue(params.SortBy), ID: last.ID}
+	}
+	return indexed, uc.search.Save(ctx)
+}
+
+// reindex loads the stored Content from the primary and puts it into the search index.
+// The write has already been committed, so failures are only logged.
+func (uc *ContentUsecase) reindex(ctx context.Context, id int64) {
+	ctx = WithPrimary(ctx)
+	indices, _, err := uc.repo.FindIndex(ctx, &FindParams{ID: id, PageSize: 1, SkipTotal: true})
+	if err == nil && len(indices) == 0 {
+		return
+	}
+	var content *Content
+	if err == nil {
+		content, err = uc.repo.First(ctx, indices[0])
+	}
+	if err == nil {
+		err = uc.search.Index(ctx, content)
+	}
+	if err != nil {
+		uc.log.WithContext(ctx).Errorf("reindex content %d error = %v", id, err)
+	}
+}
@@ -0,0 +1,54 @@
+package biz
+
+import (
+	"content_manage/api/operate"
+	"context"
+	"time"
+)
+
+// purgeBatchSize is the number of Contents purged per batch by the retention job.
+const purgeBatchSize = 500
+
+// ErrNotInTrash is returned when restoring or purging a Content that is not in the trash.
+var ErrNotInTrash = operate.ErrorContentNotFound("回收站中不存在该内容")
+
+// ListDeletedContent lists Contents in the trash.
+func (uc *ContentUsecase) ListDeletedContent(ctx context.Context, page, pageSize int32) ([]*Content, int64, error) {
+	uc.log.WithContext(ctx).Infof("ListDeletedContent: page = %d, page_size = %d", page, pageSize)
+	if page <= 0 {
+		page = 1
+	}
+	if pageSize <= 0 {
+		pageSize = defaultPageSize
+	}
+	return uc.repo.FindDeleted(ctx, page, pageSize)
+}
+
+// RestoreContent moves a Content out of the trash.
+func (uc *ContentUsecase) RestoreContent(ctx context.Context, id int64) error {
+	uc.log.WithContext(ctx).Infof("RestoreContent: %d", id)
+	if err := uc.repo.Restore(ctx, id); err != nil {
+		return err
+	}
+	uc.reindex(ctx, id)
+	return nil
+}
+
+// PurgeContent permanently deletes a Content in the trash.
+func (uc *ContentUsecase) PurgeContent(ctx context.Context, id int64) error {
+	uc.log.WithContext(ctx).Infof("PurgeContent: %d", id)
+	return uc.repo.Purge(ctx, id)
+}
+
+// PurgeExpiredContent permanently deletes Contents that have been in the trash longer than retention.
+func (uc *ContentUsecase) PurgeExpiredContent(ctx context.Context, retention time.Duration) (int, error) {
+	before := time.Now().Add(-retention)
+	var purged int
+	for {
+		n, err := uc.repo.PurgeDeletedBefore(ctx, before, purgeBatchSize)
+		purged += n
+		if err != nil || n < purgeBatchSize {
+			return purged, err
+		}
+	}
+}
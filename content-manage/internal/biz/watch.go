@@ -0,0 +1,101 @@
+package biz
+
+import (
+	"content_manage/api/operate"
+	"context"
+	"time"
+)
+
+const (
+	// watchBuffer is the number of events a watcher may fall behind before it is dropped.
+	watchBuffer = 256
+	// watchHeartbeat is the idle time after which a heartbeat is sent.
+	watchHeartbeat = 15 * time.Second
+)
+
+// ErrWatchTooSlow is returned when a watcher does not keep up with the events, it can resume from its last token.
+var ErrWatchTooSlow = operate.ErrorWatchTooSlow("接收事件过慢, 请使用 resume_token 重新订阅")
+
+// ErrInvalidResumeToken is returned when a resume token is not an event offset.
+var ErrInvalidResumeToken = operate.ErrorInvalidContent("resume_token 无效")
+
+// WatchFilter selects the events of a watcher, an empty field matches every Content.
+type WatchFilter struct {
+	Author         string
+	Category       string
+	ApprovalStatus []int32
+}
+
+func (f *WatchFilter) match(c *Content) bool {
+	if f.Author != "" && c.Author != f.Author {
+		return false
+	}
+	if f.Category != "" && c.Category != f.Category {
+		return false
+	}
+	if len(f.ApprovalStatus) == 0 {
+		return true
+	}
+	for _, status := range f.ApprovalStatus {
+		if c.ApprovalStatus == status {
+			return true
+		}
+	}
+	return false
+}
+
+// WatchContent sends the committed events matching filter until ctx is done.
+// It starts after resumeToken, the Offset of the last received event, or with new events when it is empty.
+// heartbeat is called with the last sent token after watchHeartbeat without events.
+func (uc *ContentUsecase) WatchContent(ctx context.Context, filter *WatchFilter, resumeToken string,
+	send func(e *ContentEvent) error, heartbeat func(token string) error) error {
+	uc.log.WithContext(ctx).Infof("WatchContent: %+v, resume token = %s", filter, resumeToken)
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	from := resumeToken
+	if from == "" {
+		from = OffsetLatest
+	}
+	// 读取与发送分离, 发送跟不上时缓冲区写满, 断开订阅而不是阻塞读取
+	buf := make(chan *ContentEvent, watchBuffer)
+	errc := make(chan error, 1)
+	go func() {
+		errc <- uc.events.Tail(ctx, from, func(ctx context.Context, e *ContentEvent) error {
+			if !filter.match(e.Content) {
+				return nil
+			}
+			select {
+			case buf <- e:
+				return nil
+			default:
+				return ErrWatchTooSlow
+			}
+		})
+	}()
+
+	ticker := time.NewTicker(watchHeartbeat)
+	defer ticker.Stop()
+	token := resumeToken
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case err := <-errc:
+			if err == ErrWatchTooSlow {
+				uc.log.WithContext(ctx).Warnf("WatchContent: watcher is too slow, last token = %s", token)
+			}
+			return err
+		case e := <-buf:
+			if err := send(e); err != nil {
+				return err
+			}
+			token = e.Offset
+			ticker.Reset(watchHeartbeat)
+		case <-ticker.C:
+			if err := heartbeat(token); err != nil {
+				return err
+			}
+		}
+	}
+}
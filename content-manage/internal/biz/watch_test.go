@@ -0,0 +1,185 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// fakeEventBus 只实现 Tail, offset 为事件序号
+type fakeEventBus struct {
+	EventBus
+	mu        sync.Mutex
+	events    []*ContentEvent
+	published chan struct{}
+}
+
+func newFakeEventBus() *fakeEventBus {
+	return &fakeEventBus{published: make(chan struct{})}
+}
+
+func (b *fakeEventBus) Publish(ctx context.Context, events ...*ContentEvent) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for _, e := range events {
+		e.Offset = strconv.Itoa(len(b.events) + 1)
+		b.events = append(b.events, e)
+	}
+	close(b.published)
+	b.published = make(chan struct{})
+	return nil
+}
+
+func (b *fakeEventBus) Tail(ctx context.Context, from string, handler EventHandler) error {
+	b.mu.Lock()
+	next := len(b.events)
+	b.mu.Unlock()
+	if from != OffsetLatest {
+		n, err := strconv.Atoi(from)
+		if err != nil {
+			return ErrInvalidResumeToken
+		}
+		next = n
+	}
+	for {
+		b.mu.Lock()
+		events, published := b.events[next:], b.published
+		b.mu.Unlock()
+		for _, e := range events {
+			if err := handler(ctx, e); err != nil {
+				return err
+			}
+			next++
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-published:
+		}
+	}
+}
+
+func publishAuthors(t *testing.T, bus EventBus, authors ...string) {
+	t.Helper()
+	for _, author := range authors {
+		if err := bus.Publish(context.Background(), newEvent(EventContentUpdated, &Content{Author: author})); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func newTestWatch(bus EventBus) *ContentUsecase {
+	return &ContentUsecase{events: bus, log: log.NewHelper(log.NewStdLogger(io.Discard))}
+}
+
+// watchTest 在后台订阅, 返回收到的事件 offset 和 WatchContent 的返回值
+func watchTest(t *testing.T, uc *ContentUsecase, filter *WatchFilter, token string) (<-chan string, <-chan error) {
+	t.Helper()
+	ctx, cancel := context.WithCancel(context.Background())
+	offsets := make(chan string, 100)
+	errc := make(chan error, 1)
+	go func() {
+		errc <- uc.WatchContent(ctx, filter, token, func(e *ContentEvent) error {
+			offsets <- e.Offset
+			return nil
+		}, func(string) error { return nil })
+	}()
+	t.Cleanup(cancel)
+	return offsets, errc
+}
+
+func expectWatched(t *testing.T, offsets <-chan string, want ...string) {
+	t.Helper()
+	for _, w := range want {
+		select {
+		case got := <-offsets:
+			if got != w {
+				t.Fatalf("offset = %s, want %s", got, w)
+			}
+		case <-time.After(3 * time.Second):
+			t.Fatalf("timed out waiting for offset %s", w)
+		}
+	}
+	select {
+	case got := <-offsets:
+		t.Fatalf("unexpected offset %s", got)
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+func TestWatchContentResume(t *testing.T) {
+	bus := newFakeEventBus()
+	uc := newTestWatch(bus)
+	publishAuthors(t, bus, "a", "b", "a", "a")
+
+	// 从 resume_token 之后继续, 只发送匹配的事件
+	offsets, _ := watchTest(t, uc, &WatchFilter{Author: "a"}, "1")
+	expectWatched(t, offsets, "3", "4")
+	// 没有 resume_token 时只发送订阅后的事件
+	latest, _ := watchTest(t, uc, &WatchFilter{}, "")
+	time.Sleep(50 * time.Millisecond)
+
+	publishAuthors(t, bus, "b", "a")
+	expectWatched(t, offsets, "6")
+	expectWatched(t, latest, "5", "6")
+}
+
+func TestWatchContentInvalidToken(t *testing.T) {
+	uc := newTestWatch(newFakeEventBus())
+	_, errc := watchTest(t, uc, &WatchFilter{}, "x")
+	if err := <-errc; !errors.Is(err, ErrInvalidResumeToken) {
+		t.Fatalf("WatchContent() error = %v, want ErrInvalidResumeToken", err)
+	}
+}
+
+func TestWatchContentTooSlow(t *testing.T) {
+	bus := newFakeEventBus()
+	uc := newTestWatch(bus)
+	authors := make([]string, 2*watchBuffer)
+	publishAuthors(t, bus, authors...)
+
+	// 第一个事件阻塞到缓冲区写满, 之后按最后收到的 offset 恢复
+	release := make(chan struct{})
+	var sent []string
+	errc := make(chan error, 1)
+	go func() {
+		errc <- uc.WatchContent(context.Background(), &WatchFilter{}, OffsetOldest, func(e *ContentEvent) error {
+			if len(sent) == 0 {
+				<-release
+			}
+			sent = append(sent, e.Offset)
+			return nil
+		}, func(string) error { return nil })
+	}()
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+	if err := <-errc; !errors.Is(err, ErrWatchTooSlow) {
+		t.Fatalf("WatchContent() error = %v, want ErrWatchTooSlow", err)
+	}
+	if len(sent) == 0 || len(sent) > watchBuffer+1 {
+		t.Fatalf("sent %d events before dropping, want 1 to %d", len(sent), watchBuffer+1)
+	}
+	for i, offset := range sent {
+		if offset != strconv.Itoa(i+1) {
+			t.Fatalf("sent[%d] = %s, want %d", i, offset, i+1)
+		}
+	}
+
+	token := sent[len(sent)-1]
+	offsets, _ := watchTest(t, uc, &WatchFilter{}, token)
+	last, _ := strconv.Atoi(token)
+	select {
+	case got := <-offsets:
+		if got != strconv.Itoa(last+1) {
+			t.Fatalf("resumed at %s, want %d", got, last+1)
+		}
+	case <-time.After(3 * time.Second):
+		t.Fatal("timed out waiting for the resumed watch")
+	}
+}
@@ -0,0 +1,148 @@
+package data
+
+import (
+	"content_manage/internal/biz"
+	"context"
+	"errors"
+	"testing"
+)
+
+// batchTitles 返回每个结果的标题, 失败的结果为空
+func batchTitles(results []*biz.BatchResult) []string {
+	titles := make([]string, len(results))
+	for i, r := range results {
+		if r.Content != nil {
+			titles[i] = r.Content.Title
+		}
+	}
+	return titles
+}
+
+func TestBatchCreateContentPartial(t *testing.T) {
+	uc, d := newTestUsecase(t)
+	ctx := context.Background()
+	existing := createUsecaseContent(t, uc, "existing")
+
+	// 第二条缺少必填字段, 第三条的 id 已被使用, 整批写入失败后逐条写入
+	contents := []*biz.Content{
+		newTestContent("alpha"),
+		{Title: "bravo"},
+		{ID: existing, Title: "charlie", VideoURL: "https://example.com/charlie.mp4", Author: "author"},
+		newTestContent("delta"),
+	}
+	results, err := uc.BatchCreateContent(ctx, contents, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if titles := batchTitles(results); titles[0] != "alpha" || titles[1] != "" || titles[2] != "" || titles[3] != "delta" {
+		t.Fatalf("BatchCreateContent() titles = %q, want [alpha  delta] in request order", titles)
+	}
+	if !errors.Is(results[1].Err, biz.ErrInvalidContent) {
+		t.Fatalf("BatchCreateContent() invalid item error = %v, want ErrInvalidContent", results[1].Err)
+	}
+	if results[2].Err == nil {
+		t.Fatal("BatchCreateContent() duplicate item error = nil")
+	}
+	if n := countContents(t, d); n != 3 {
+		t.Fatalf("contents = %d, want the existing one and 2 created", n)
+	}
+}
+
+func TestBatchCreateContentAtomic(t *testing.T) {
+	uc, d := newTestUsecase(t)
+	ctx := context.Background()
+
+	// 校验失败时不写入, 其余条目标记为已回滚
+	results, err := uc.BatchCreateContent(ctx, []*biz.Content{newTestContent("alpha"), {Title: "bravo"}}, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !errors.Is(results[0].Err, biz.ErrBatchAborted) || !errors.Is(results[1].Err, biz.ErrInvalidContent) {
+		t.Fatalf("BatchCreateContent() errors = %v, %v, want ErrBatchAborted, ErrInvalidContent", results[0].Err, results[1].Err)
+	}
+	if n := countContents(t, d); n != 0 {
+		t.Fatalf("contents = %d after an aborted batch, want 0", n)
+	}
+
+	// 写入失败时整批回滚
+	existing := createUsecaseContent(t, uc, "existing")
+	contents := []*biz.Content{
+		newTestContent("alpha"),
+		{ID: existing, Title: "charlie", VideoURL: "https://example.com/charlie.mp4", Author: "author"},
+	}
+	results, err = uc.BatchCreateContent(ctx, contents, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, r := range results {
+		if r.Err == nil || r.Content != nil {
+			t.Fatalf("BatchCreateContent() result %d = %+v, want an error", i, r)
+		}
+	}
+	if n := countContents(t, d); n != 1 {
+		t.Fatalf("contents = %d after a failed batch, want only the existing one", n)
+	}
+
+	results, err = uc.BatchCreateContent(ctx, []*biz.Content{newTestContent("alpha"), newTestContent("bravo")}, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if titles := batchTitles(results); titles[0] != "alpha" || titles[1] != "bravo" || results[0].Err != nil || results[1].Err != nil {
+		t.Fatalf("BatchCreateContent() titles = %q, want [alpha bravo]", titles)
+	}
+}
+
+func TestBatchGetContent(t *testing.T) {
+	uc, _ := newTestUsecase(t)
+	ctx := context.Background()
+	alpha := createUsecaseContent(t, uc, "alpha")
+	bravo := createUsecaseContent(t, uc, "bravo")
+
+	results, err := uc.BatchGetContent(ctx, []int64{bravo, 404, alpha})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if titles := batchTitles(results); titles[0] != "bravo" || titles[1] != "" || titles[2] != "alpha" {
+		t.Fatalf("BatchGetContent() titles = %q, want [bravo  alpha]", titles)
+	}
+	if !errors.Is(results[1].Err, biz.ErrContentNotFound) {
+		t.Fatalf("BatchGetContent() missing item error = %v, want ErrContentNotFound", results[1].Err)
+	}
+}
+
+func TestBatchDeleteContent(t *testing.T) {
+	uc, _ := newTestUsecase(t)
+	ctx := context.Background()
+	alpha := createUsecaseContent(t, uc, "alpha")
+	bravo := createUsecaseContent(t, uc, "bravo")
+	charlie := createUsecaseContent(t, uc, "charlie")
+
+	// 任一条失败时整批回滚
+	results, err := uc.BatchDeleteContent(ctx, []int64{alpha, 404, bravo}, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !errors.Is(results[0].Err, biz.ErrBatchAborted) || !errors.Is(results[1].Err, biz.ErrContentNotFound) || !errors.Is(results[2].Err, biz.ErrBatchAborted) {
+		t.Fatalf("BatchDeleteContent() errors = %v, %v, %v, want aborted, not found, aborted", results[0].Err, results[1].Err, results[2].Err)
+	}
+	for _, id := range []int64{alpha, bravo} {
+		if _, err := uc.GetContent(ctx, id, ""); err != nil {
+			t.Fatalf("GetContent(%d) after an aborted delete error = %v", id, err)
+		}
+	}
+
+	// 非原子时各条独立
+	results, err = uc.BatchDeleteContent(ctx, []int64{alpha, 404, charlie}, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if results[0].Err != nil || !errors.Is(results[1].Err, biz.ErrContentNotFound) || results[2].Err != nil {
+		t.Fatalf("BatchDeleteContent() errors = %v, %v, %v, want nil, not found, nil", results[0].Err, results[1].Err, results[2].Err)
+	}
+	for id, deleted := range map[int64]bool{alpha: true, bravo: false, charlie: true} {
+		_, err := uc.GetContent(ctx, id, "")
+		if deleted != errors.Is(err, biz.ErrContentNotFound) {
+			t.Fatalf("GetContent(%d) error = %v, want deleted %t", id, err, deleted)
+		}
+	}
+}
@@ -1,102 +1,152 @@
 package data
 
 import (
+	"content_manage/api/operate"
 	"content_manage/internal/biz"
+	"content_manage/internal/conf"
 	"context"
 	"fmt"
-	"hash/fnv"
-	"math/big"
+	"sync"
 	"time"
 
 	"github.com/go-kratos/kratos/v2/log"
+	"golang.org/x/sync/errgroup"
 	"gorm.io/gorm"
 )
 
-const contentNumTables = 4
-
 type contentRepo struct {
 	data *Data
 	log  *log.Helper
 }
 
-// NewContentRepo .
-func NewContentRepo(data *Data, logger log.Logger) biz.ContentRepo {
-	return &contentRepo{
+// NewContentRepo 配置了 redis 时在详情读取外包装缓存
+func NewContentRepo(c *conf.Data, data *Data, logger log.Logger) biz.ContentRepo {
+	repo := &contentRepo{
 		data: data,
 		log:  log.NewHelper(logger),
 	}
+	if data.rdb == nil {
+		return repo
+	}
+	rc := c.GetRedis()
+	return newCachedContentRepo(repo, data.rdb, rc.GetCacheTtl().AsDuration(), rc.GetNegativeTtl().AsDuration())
 }
 
 type ContentDetail struct {
-	ID             int64         `gorm:"column:id;primaryKey"` // 内容ID
-	Title          string        `gorm:"column:title"`         // 内容标题
-	ContentID      string        `gorm:"column:content_id"`
-	Description    string        `gorm:"column:description"`     // 内容描述
-	Author         string        `gorm:"column:author"`          // 作者
-	VideoURL       string        `gorm:"column:video_url"`       // 视频链接
-	Thumbnail      string        `gorm:"column:thumbnail"`       // 封面图
-	Category       string        `gorm:"column:category"`        // 内容分类
-	Duration       time.Duration `gorm:"column:duration"`        // 内容时长
-	Resolution     string        `gorm:"column:resolution"`      // 分辨率 如 720p 1080p
-	FileSize       int64         `gorm:"column:file_size"`       // 文件大小
-	Format         string        `gorm:"column:format"`          // 文件格式, 如 mp4 avi
-	Quality        int32         `gorm:"column:quality"`         // 视频质量 1-高清 2-标清 3-流畅
-	ApprovalStatus int32         `gorm:"column:approval_status"` // 审核状态 1-审核中 2-审核通过 3-审核不通过
-	CreatedAt      time.Time     `gorm:"column:created_at"`      // 创建时间
-	UpdatedAt      time.Time     `gorm:"column:updated_at"`      // 更新时间
+	ID             int64          `gorm:"column:id;primaryKey"` // 内容ID
+	Title          string         `gorm:"column:title"`         // 内容标题
+	ContentID      string         `gorm:"column:content_id"`
+	Description    string         `gorm:"column:description"`     // 内容描述
+	Author         string         `gorm:"column:author"`          // 作者
+	VideoURL       string         `gorm:"column:video_url"`       // 视频链接
+	Thumbnail      string         `gorm:"column:thumbnail"`       // 封面图
+	Category       string         `gorm:"column:category"`        // 内容分类
+	Duration       time.Duration  `gorm:"column:duration"`        // 内容时长, 单位纳秒
+	Resolution     string         `gorm:"column:resolution"`      // 分辨率 如 720p 1080p
+	FileSize       int64          `gorm:"column:file_size"`       // 文件大小
+	Format         string         `gorm:"column:format"`          // 文件格式, 如 mp4 avi
+	Quality        int32          `gorm:"column:quality"`         // 视频质量 1-高清 2-标清 3-流畅
+	ApprovalStatus int32          `gorm:"column:approval_status"` // 审核状态 1-审核中 2-审核通过 3-审核不通过
+	Version        int64          `gorm:"column:version"`         // 版本号, 与索引表一致, 每次更新加 1
+	CreatedAt      time.Time      `gorm:"column:created_at"`      // 创建时间
+	UpdatedAt      time.Time      `gorm:"column:updated_at"`      // 更新时间
+	DeletedAt      gorm.DeletedAt `gorm:"column:deleted_at"`      // 删除时间, 非空表示在回收站中
 }
 
 // func (*ContentDetail) TableName() string {
 // 	return "cms_content.t_content_details"
 // }
 
+// IdxContentDetail 索引表, 冗余详情中用于筛选和排序的字段, 查询时不必扫描所有详情表
 type IdxContentDetail struct {
-	ID        int64     `gorm:"column:id;primaryKey"`
-	ContentID string    `gorm:"column:content_id"`
-	Title     string    `gorm:"column:title"`
-	Author    string    `gorm:"column:author"`
-	CreatedAt time.Time `gorm:"column:created_at"`
-	UpdatedAt time.Time `gorm:"column:updated_at"`
+	ID             int64          `gorm:"column:id;primaryKey"`
+	ContentID      string         `gorm:"column:content_id"`
+	Title          string         `gorm:"column:title"`
+	Author         string         `gorm:"column:author"`
+	Category       string         `gorm:"column:category"`
+	Duration       time.Duration  `gorm:"column:duration"`
+	Resolution     string         `gorm:"column:resolution"`
+	FileSize       int64          `gorm:"column:file_size"`
+	Format         string         `gorm:"column:format"`
+	Quality        int32          `gorm:"column:quality"`
+	ApprovalStatus int32          `gorm:"column:approval_status"`
+	Version        int64          `gorm:"column:version"`
+	CreatedAt      time.Time      `gorm:"column:created_at"`
+	UpdatedAt      time.Time      `gorm:"column:updated_at"`
+	DeletedAt      gorm.DeletedAt `gorm:"column:deleted_at"`
 }
 
 func (c *IdxContentDetail) TableName() string {
 	return "cms_content.t_idx_content_details"
 }
 
-func getContentDetailTable(contentID string) string {
-	tableIndex := getContentTableIndex(contentID)
-	table := fmt.Sprintf("cms_content.t_content_details_%d", tableIndex)
-	log.Infof("content_id = %s, table = %s", contentID, table)
+// getContentDetailTable 根据 content_id 路由到读取的详情表
+func (c *contentRepo) getContentDetailTable(contentID string) string {
+	router := c.data.router
+	if c.data.reshard != nil {
+		router = c.data.reshard.readRouter()
+	}
+	table := router.Table(contentID)
+	c.log.Infof("content_id = %s, table = %s", contentID, table)
 	return table
 }
 
-func getContentTableIndex(uuid string) int {
-	hash := fnv.New64()
-	hash.Write([]byte(uuid))
-	hashValue := hash.Sum64()
-	fmt.Println("hashValue = ", hashValue)
+// getContentDetailTables 根据 content_id 路由到写入的详情表, 重分片双写期间会返回新旧两张表
+func (c *contentRepo) getContentDetailTables(contentID string) []string {
+	if c.data.reshard != nil {
+		return c.data.reshard.writeTables(contentID)
+	}
+	return []string{c.data.router.Table(contentID)}
+}
 
-	bigNum := big.NewInt(int64(hashValue))
-	mod := big.NewInt(contentNumTables)
-	tableIndex := bigNum.Mod(bigNum, mod).Int64()
-	return int(tableIndex)
+func (c *contentRepo) ExecTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	return c.data.ExecTx(ctx, fn)
 }
 
 func (c *contentRepo) Create(ctx context.Context, content *biz.Content) (int64, error) {
 	c.log.Infof("contentRepo Create content = %+v", content)
-	db := c.data.db
-
-	idx := IdxContentDetail{
-		ContentID: content.ContentID,
-		Title:     content.Title,
-		Author:    content.Author,
-	}
-	if err := db.Create(&idx).Error; err != nil {
-		c.log.Errorf("content create error = %v\n", err)
+	idx, detail := newContentRows(content)
+	// 索引表和详情表在同一个事务中写入, 详情表在其他分片库时各库分别开启事务
+	err := c.data.ExecTx(ctx, func(ctx context.Context) error {
+		if err := c.data.DB(ctx).Create(idx).Error; err != nil {
+			return err
+		}
+		for _, table := range c.getContentDetailTables(content.ContentID) {
+			row := *detail
+			db, err := c.data.writeDetailDB(ctx, table, []string{content.ContentID})
+			if err != nil {
+				return err
+			}
+			if err := db.Create(&row).Error; err != nil {
+				return c.data.shardError(table, err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		c.log.WithContext(ctx).Errorf("content create error = %v\n", err)
 		return 0, err
 	}
+	return idx.ID, nil
+}
 
-	detail := ContentDetail{
+// newContentRows 新内容的索引行和详情行, id 由 biz 预先分配, 版本号从 1 开始
+func newContentRows(content *biz.Content) (*IdxContentDetail, *ContentDetail) {
+	idx := &IdxContentDetail{
+		ID:             content.ID,
+		ContentID:      content.ContentID,
+		Title:          content.Title,
+		Author:         content.Author,
+		Category:       content.Category,
+		Duration:       content.Duration,
+		Resolution:     content.Resolution,
+		FileSize:       content.FileSize,
+		Format:         content.Format,
+		Quality:        content.Quality,
+		ApprovalStatus: content.ApprovalStatus,
+		Version:        1,
+	}
+	detail := &ContentDetail{
 		Title:          content.Title,
 		ContentID:      content.ContentID,
 		Description:    content.Description,
@@ -110,23 +160,91 @@ func (c *contentRepo) Create(ctx context.Context, content *biz.Content) (int64,
 		Format:         content.Format,
 		Quality:        content.Quality,
 		ApprovalStatus: content.ApprovalStatus,
+		Version:        1,
 	}
+	return idx, detail
+}
 
-	if err := db.Table(getContentDetailTable(content.ContentID)).Create(&detail).Error; err != nil {
-		c.log.Errorf("content create error = %v\n", err)
-		return 0, err
+// BatchCreate 索引表一次多行插入, 详情按分片表分组, 每张表一次多行插入
+func (c *contentRepo) BatchCreate(ctx context.Context, contents []*biz.Content) ([]int64, error) {
+	c.log.Infof("contentRepo BatchCreate %d contents", len(contents))
+	indices := make([]*IdxContentDetail, 0, len(contents))
+	details := make(map[string][]*ContentDetail)
+	for _, content := range contents {
+		idx, detail := newContentRows(content)
+		indices = append(indices, idx)
+		for _, table := range c.getContentDetailTables(content.ContentID) {
+			row := *detail
+			details[table] = append(details[table], &row)
+		}
 	}
-	return idx.ID, nil
+	err := c.data.ExecTx(ctx, func(ctx context.Context) error {
+		if err := c.data.DB(ctx).Create(&indices).Error; err != nil {
+			return err
+		}
+		for table, rows := range details {
+			db, err := c.data.writeDetailDB(ctx, table, detailContentIDs(rows))
+			if err != nil {
+				return err
+			}
+			if err := db.Create(&rows).Error; err != nil {
+				return c.data.shardError(table, err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		c.log.WithContext(ctx).Errorf("content batch create error = %v\n", err)
+		return nil, err
+	}
+	ids := make([]int64, 0, len(indices))
+	for _, idx := range indices {
+		ids = append(ids, idx.ID)
+	}
+	return ids, nil
+}
+
+func detailContentIDs(rows []*ContentDetail) []string {
+	contentIDs := make([]string, 0, len(rows))
+	for _, row := range rows {
+		contentIDs = append(contentIDs, row.ContentID)
+	}
+	return contentIDs
+}
+
+// idxColumns 索引表中冗余的详情列
+var idxColumns = map[string]bool{
+	"title":           true,
+	"author":          true,
+	"category":        true,
+	"duration":        true,
+	"resolution":      true,
+	"file_size":       true,
+	"format":          true,
+	"quality":         true,
+	"approval_status": true,
 }
 
 func (c *contentRepo) Update(ctx context.Context, id int64, content *biz.Content) error {
-	c.log.Infof("contentRepo Update content = %+v", content)
-	db := c.data.db
-	var idx IdxContentDetail
-	if err := db.Where("id = ?", id).First(&idx).Error; err != nil {
-		return err
+	return c.update(ctx, id, content, nil)
+}
+
+func (c *contentRepo) UpdateFields(ctx context.Context, id int64, content *biz.Content, fields []string) error {
+	if len(fields) == 0 {
+		return nil
 	}
+	return c.update(ctx, id, content, fields)
+}
 
+// update 更新 fields 指定的列, fields 为 nil 时只更新非零值字段
+func (c *contentRepo) update(ctx context.Context, id int64, content *biz.Content, fields []string) error {
+	c.log.Infof("contentRepo Update content = %+v, fields = %v", content, fields)
+	var idxFields []string
+	for _, field := range fields {
+		if idxColumns[field] {
+			idxFields = append(idxFields, field)
+		}
+	}
 	detail := ContentDetail{
 		Title:          content.Title,
 		Description:    content.Description,
@@ -141,15 +259,68 @@ func (c *contentRepo) Update(ctx context.Context, id int64, content *biz.Content
 		Quality:        content.Quality,
 		ApprovalStatus: content.ApprovalStatus,
 	}
-	if err := db.Table(getContentDetailTable(idx.ContentID)).Where("content_id = ?", idx.ContentID).Updates(&detail).Error; err != nil {
-		c.log.Errorf("content update error = %v\n", err)
+	err := c.data.ExecTx(ctx, func(ctx context.Context) error {
+		db := c.data.DB(ctx)
+		var idx IdxContentDetail
+		err := db.Where("id = ?", id).First(&idx).Error
+		if err == gorm.ErrRecordNotFound {
+			return biz.ErrContentNotFound
+		}
+		if err != nil {
+			return err
+		}
+		if content.Version != 0 && content.Version != idx.Version {
+			return biz.ErrVersionConflict
+		}
+		detail.Version = idx.Version + 1
+		// 同步索引表中的冗余字段, 保证筛选结果与详情一致; 按读到的版本号更新, 期间被其他事务修改时不生效
+		idxQuery := db.Model(&idx).Where("version = ?", idx.Version)
+		if fields != nil {
+			// 未改动冗余列时只更新版本号和更新时间
+			idxQuery = idxQuery.Select(append(idxFields, "version", "updated_at"))
+		}
+		result := idxQuery.Updates(&IdxContentDetail{
+			Title:          content.Title,
+			Author:         content.Author,
+			Category:       content.Category,
+			Duration:       content.Duration,
+			Resolution:     content.Resolution,
+			FileSize:       content.FileSize,
+			Format:         content.Format,
+			Quality:        content.Quality,
+			ApprovalStatus: content.ApprovalStatus,
+			Version:        detail.Version,
+		})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return biz.ErrVersionConflict
+		}
+		for _, table := range c.getContentDetailTables(idx.ContentID) {
+			query, err := c.data.writeDetailDB(ctx, table, []string{idx.ContentID})
+			if err != nil {
+				return err
+			}
+			query = query.Where("content_id = ?", idx.ContentID)
+			if fields != nil {
+				query = query.Select(append(fields, "version"))
+			}
+			if err := query.Updates(&detail).Error; err != nil {
+				return c.data.shardError(table, err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		c.log.WithContext(ctx).Errorf("content update error = %v\n", err)
 		return err
 	}
 	return nil
 }
 
 func (c *contentRepo) IsExist(ctx context.Context, id int64) (bool, error) {
-	db := c.data.db
+	db := c.data.ReadDB(ctx)
 	var detail IdxContentDetail
 	err := db.Where("id = ?", id).First(&detail).Error
 	if err == gorm.ErrRecordNotFound {
@@ -162,29 +333,47 @@ func (c *contentRepo) IsExist(ctx context.Context, id int64) (bool, error) {
 	return true, nil
 }
 
-func (c *contentRepo) Delete(ctx context.Context, id int64) error {
-	db := c.data.db
-	// 查询索引表信息
-	var idx IdxContentDetail
-	if err := db.Where("id = ?", id).First(&idx).Error; err != nil {
-		return err
-	}
-	// 删除索引信息
-	if err := db.Where("id = ?", id).Delete(&IdxContentDetail{}).Error; err != nil {
-		c.log.WithContext(ctx).Errorf("ContentDao IdxContentDetail Delete error = %v\n", err)
-		return err
-	}
-	// 删除详情信息
-	if err := db.Table(getContentDetailTable(idx.ContentID)).
-		Where("content_id = ?", idx.ContentID).Delete(&ContentDetail{}).Error; err != nil {
-		c.log.WithContext(ctx).Errorf("ContentDao ContentDetail Delete error = %v\n", err)
-		return err
-	}
-	return nil
+func (c *contentRepo) Delete(ctx context.Context, id int64, version int64) error {
+	return c.data.ExecTx(ctx, func(ctx context.Context) error {
+		db := c.data.DB(ctx)
+		// 查询索引表信息
+		var idx IdxContentDetail
+		err := db.Where("id = ?", id).First(&idx).Error
+		if err == gorm.ErrRecordNotFound {
+			return biz.ErrContentNotFound
+		}
+		if err != nil {
+			return err
+		}
+		if version != 0 && version != idx.Version {
+			return biz.ErrVersionConflict
+		}
+		// 软删除索引信息, 进入回收站
+		result := db.Where("id = ? AND version = ?", id, idx.Version).Delete(&IdxContentDetail{})
+		if result.Error != nil {
+			c.log.WithContext(ctx).Errorf("ContentDao IdxContentDetail Delete error = %v\n", result.Error)
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return biz.ErrVersionConflict
+		}
+		// 软删除详情信息
+		for _, table := range c.getContentDetailTables(idx.ContentID) {
+			query, err := c.data.writeDetailDB(ctx, table, []string{idx.ContentID})
+			if err != nil {
+				return err
+			}
+			if err := query.Where("content_id = ?", idx.ContentID).Delete(&ContentDetail{}).Error; err != nil {
+				c.log.WithContext(ctx).Errorf("ContentDao ContentDetail Delete error = %v\n", err)
+				return c.data.shardError(table, err)
+			}
+		}
+		return nil
+	})
 }
 
 func (c *contentRepo) Find(ctx context.Context, params *biz.FindParams) ([]*biz.Content, int64, error) {
-	query := c.data.db.Model(&ContentDetail{})
+	query := c.data.DB(ctx).Model(&ContentDetail{})
 	if params.ID != 0 {
 		query = query.Where("id = ?", params.ID)
 	}
@@ -239,21 +428,70 @@ func (c *contentRepo) Find(ctx context.Context, params *biz.FindParams) ([]*biz.
 	return contents, total, nil
 }
 
+// sortColumns 排序字段对应的索引表列
+var sortColumns = map[biz.SortBy]string{
+	biz.SortByCreatedAt: "created_at",
+	biz.SortByUpdatedAt: "updated_at",
+	biz.SortByDuration:  "duration",
+	biz.SortByFileSize:  "file_size",
+}
+
 func (c *contentRepo) FindIndex(ctx context.Context, params *biz.FindParams) ([]*biz.ContentIndex, int64, error) {
-	// 构建查询条件
-	query := c.data.db.Model(&IdxContentDetail{})
+	// 构建查询条件, 包括总数在内都从从库读取
+	query := c.data.ReadDB(ctx).Model(&IdxContentDetail{})
 	if params.ID != 0 {
 		query = query.Where("id = ?", params.ID)
 	}
+	if len(params.IDs) > 0 {
+		query = query.Where("id IN ?", params.IDs)
+	}
+	if params.ContentID != "" {
+		query = query.Where("content_id = ?", params.ContentID)
+	}
 	if params.Author != "" {
 		query = query.Where("author = ?", params.Author)
 	}
 	if params.Title != "" {
 		query = query.Where("title LIKE ?", "%"+params.Title+"%")
 	}
+	if params.Category != "" {
+		query = query.Where("category = ?", params.Category)
+	}
+	if len(params.ApprovalStatus) > 0 {
+		query = query.Where("approval_status IN ?", params.ApprovalStatus)
+	}
+	if params.Quality != 0 {
+		query = query.Where("quality = ?", params.Quality)
+	}
+	if params.Format != "" {
+		query = query.Where("format = ?", params.Format)
+	}
+	if params.Resolution != "" {
+		query = query.Where("resolution = ?", params.Resolution)
+	}
+	if !params.CreatedAfter.IsZero() {
+		query = query.Where("created_at >= ?", params.CreatedAfter)
+	}
+	if !params.CreatedBefore.IsZero() {
+		query = query.Where("created_at < ?", params.CreatedBefore)
+	}
+	if !params.UpdatedAfter.IsZero() {
+		query = query.Where("updated_at >= ?", params.UpdatedAfter)
+	}
+	if !params.UpdatedBefore.IsZero() {
+		query = query.Where("updated_at < ?", params.UpdatedBefore)
+	}
+	if params.MinDuration > 0 {
+		query = query.Where("duration >= ?", params.MinDuration)
+	}
+	if params.MaxDuration > 0 {
+		query = query.Where("duration <= ?", params.MaxDuration)
+	}
 	var total int64
-	if err := query.Count(&total).Error; err != nil {
-		return nil, 0, err
+	if !params.SkipTotal {
+		if err := query.Count(&total).Error; err != nil {
+			return nil, 0, err
+		}
 	}
 
 	var page, pageSize = 1, 10
@@ -263,9 +501,28 @@ func (c *contentRepo) FindIndex(ctx context.Context, params *biz.FindParams) ([]
 	if params.PageSize > 0 {
 		pageSize = int(params.PageSize)
 	}
-	offset := (page - 1) * pageSize
+	column, ok := sortColumns[params.SortBy]
+	if !ok {
+		column = sortColumns[biz.SortByCreatedAt]
+	}
+	direction, cmp := "ASC", ">"
+	if params.Desc {
+		direction, cmp = "DESC", "<"
+	}
+	query = query.Order(fmt.Sprintf("%s %s, id %s", column, direction, direction))
+	if params.After != nil {
+		// 游标分页, 从上一页最后一行之后开始读取
+		var value interface{} = params.After.Value
+		if params.SortBy == biz.SortByCreatedAt || params.SortBy == biz.SortByUpdatedAt {
+			value = time.Unix(0, params.After.Value)
+		}
+		query = query.Where(fmt.Sprintf("(%s %s ? OR (%s = ? AND id %s ?))", column, cmp, column, cmp),
+			value, value, params.After.ID)
+	} else {
+		query = query.Offset((page - 1) * pageSize)
+	}
 	var results []*IdxContentDetail
-	if err := query.Offset(offset).
+	if err := query.
 		Limit(pageSize).
 		Find(&results).Error; err != nil {
 		c.log.WithContext(ctx).Errorf("contentRepo FindIndex error = %v\n", err)
@@ -277,6 +534,10 @@ func (c *contentRepo) FindIndex(ctx context.Context, params *biz.FindParams) ([]
 		contents = append(contents, &biz.ContentIndex{
 			ID:        v.ID,
 			ContentID: v.ContentID,
+			Duration:  v.Duration,
+			FileSize:  v.FileSize,
+			CreatedAt: v.CreatedAt,
+			UpdatedAt: v.UpdatedAt,
 		})
 	}
 
@@ -286,16 +547,123 @@ func (c *contentRepo) FindIndex(ctx context.Context, params *biz.FindParams) ([]
 }
 
 func (c *contentRepo) First(ctx context.Context, idx *biz.ContentIndex) (*biz.Content, error) {
-	db := c.data.db
+	detail, err := c.firstDetail(ctx, idx.ContentID)
+	if err == gorm.ErrRecordNotFound {
+		return nil, biz.ErrContentNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return toBizContent(idx, detail), nil
+}
+
+func (c *contentRepo) firstDetail(ctx context.Context, contentID string) (*ContentDetail, error) {
+	table := c.getContentDetailTable(contentID)
+	db, err := c.data.readDetailDB(ctx, table)
+	if err != nil {
+		return nil, err
+	}
 	var detail ContentDetail
-	c.log.Infof("contentRepo First ContentID = %s", idx.ContentID)
-	if err := db.Table(getContentDetailTable(idx.ContentID)).
-		Where("content_id = ?", idx.ContentID).First(&detail).Error; err != nil {
+	c.log.Infof("contentRepo First ContentID = %s", contentID)
+	if err := db.Where("content_id = ?", contentID).First(&detail).Error; err != nil {
 		c.log.WithContext(ctx).Errorf("contentRepo First error = %v\n", err)
+		if err == gorm.ErrRecordNotFound {
+			return nil, err
+		}
+		return nil, c.data.shardError(table, err)
+	}
+	return &detail, nil
+}
+
+// FindByContentIDs 按详情表分组, 每张表一次 IN 查询, 结果按 indices 的顺序返回
+func (c *contentRepo) FindByContentIDs(ctx context.Context, indices []*biz.ContentIndex) ([]*biz.Content, error) {
+	contentIDs := make([]string, 0, len(indices))
+	for _, idx := range indices {
+		contentIDs = append(contentIDs, idx.ContentID)
+	}
+	details, unavailable, err := c.findDetails(ctx, contentIDs, false)
+	if err != nil {
 		return nil, err
 	}
 
-	content := &biz.Content{
+	contents := make([]*biz.Content, 0, len(indices))
+	for _, idx := range indices {
+		detail, ok := details[idx.ContentID]
+		if !ok {
+			if !unavailable[idx.ContentID] {
+				c.log.WithContext(ctx).Warnf("contentRepo FindByContentIDs content_id = %s detail not found", idx.ContentID)
+			}
+			continue
+		}
+		contents = append(contents, toBizContent(idx, detail))
+	}
+	return contents, partialError(unavailable)
+}
+
+// partialError 有内容所在的分片库不可用时返回 *biz.PartialError
+func partialError(unavailable map[string]bool) error {
+	if len(unavailable) == 0 {
+		return nil
+	}
+	contentIDs := make([]string, 0, len(unavailable))
+	for contentID := range unavailable {
+		contentIDs = append(contentIDs, contentID)
+	}
+	return &biz.PartialError{ContentIDs: contentIDs, Err: biz.ErrShardUnavailable}
+}
+
+// findDetails 返回 content_id 到详情的映射, 不存在的 content_id 不在结果中; deleted 为 true 时只查回收站.
+// 分片库不可用时不影响其他分片的结果, 该库上的 content_id 在 unavailable 中返回
+func (c *contentRepo) findDetails(ctx context.Context, contentIDs []string, deleted bool) (details map[string]*ContentDetail, unavailable map[string]bool, err error) {
+	groups := make(map[string][]string)
+	for _, contentID := range contentIDs {
+		table := c.getContentDetailTable(contentID)
+		groups[table] = append(groups[table], contentID)
+	}
+
+	var mu sync.Mutex
+	details = make(map[string]*ContentDetail, len(contentIDs))
+	unavailable = make(map[string]bool)
+	// 不使用 errgroup.WithContext, 一个分片失败时不取消其他分片的查询
+	var eg errgroup.Group
+	for table, contentIDs := range groups {
+		table, contentIDs := table, contentIDs
+		eg.Go(func() error {
+			var rows []*ContentDetail
+			query, err := c.data.readDetailDB(ctx, table)
+			if err == nil {
+				if deleted {
+					query = query.Unscoped().Where("deleted_at IS NOT NULL")
+				}
+				err = c.data.shardError(table, query.Where("content_id IN ?", contentIDs).Find(&rows).Error)
+			}
+			mu.Lock()
+			defer mu.Unlock()
+			if operate.IsShardUnavailable(err) {
+				c.log.WithContext(ctx).Errorf("contentRepo FindByContentIDs table = %s unavailable, error = %v\n", table, err)
+				for _, contentID := range contentIDs {
+					unavailable[contentID] = true
+				}
+				return nil
+			}
+			if err != nil {
+				c.log.WithContext(ctx).Errorf("contentRepo FindByContentIDs table = %s error = %v\n", table, err)
+				return err
+			}
+			for _, row := range rows {
+				details[row.ContentID] = row
+			}
+			return nil
+		})
+	}
+	if err := eg.Wait(); err != nil {
+		return nil, nil, err
+	}
+	return details, unavailable, nil
+}
+
+func toBizContent(idx *biz.ContentIndex, detail *ContentDetail) *biz.Content {
+	return &biz.Content{
 		ID:             idx.ID,
 		ContentID:      idx.ContentID,
 		Title:          detail.Title,
@@ -310,8 +678,9 @@ func (c *contentRepo) First(ctx context.Context, idx *biz.ContentIndex) (*biz.Co
 		Format:         detail.Format,
 		Quality:        detail.Quality,
 		ApprovalStatus: detail.ApprovalStatus,
+		Version:        detail.Version,
 		UpdatedAt:      detail.UpdatedAt,
 		CreatedAt:      detail.CreatedAt,
+		DeletedAt:      detail.DeletedAt.Time,
 	}
-	return content, nil
 }
@@ -0,0 +1,311 @@
+package data
+
+import (
+	"content_manage/internal/biz"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/redis/go-redis/v9"
+	"golang.org/x/sync/singleflight"
+	"gorm.io/gorm"
+)
+
+const (
+	contentCacheKey = "cms:content:%s"
+	// contentCacheMissing 标记不存在的内容, 避免反复穿透到数据库
+	contentCacheMissing = "-"
+
+	defaultCacheTTL    = 10 * time.Minute
+	defaultNegativeTTL = time.Minute
+)
+
+// contentCacheRequests 缓存命中统计, op 为 first 或 batch, result 为 hit 或 miss
+var contentCacheRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+	Name: "content_cache_requests_total",
+	Help: "Total number of content detail cache lookups",
+}, []string{
+	"op",
+	"result",
+})
+
+func init() {
+	prometheus.MustRegister(contentCacheRequests)
+}
+
+// cachedContentRepo 按 content_id 缓存详情的 ContentRepo, 读时回源, 写后删除缓存
+type cachedContentRepo struct {
+	*contentRepo
+	rdb         *redis.Client
+	ttl         time.Duration
+	negativeTTL time.Duration
+	group       singleflight.Group
+}
+
+func newCachedContentRepo(repo *contentRepo, rdb *redis.Client, ttl, negativeTTL time.Duration) *cachedContentRepo {
+	if ttl <= 0 {
+		ttl = defaultCacheTTL
+	}
+	if negativeTTL <= 0 {
+		negativeTTL = defaultNegativeTTL
+	}
+	return &cachedContentRepo{
+		contentRepo: repo,
+		rdb:         rdb,
+		ttl:         ttl,
+		negativeTTL: negativeTTL,
+	}
+}
+
+func cacheKey(contentID string) string {
+	return fmt.Sprintf(contentCacheKey, contentID)
+}
+
+func (c *cachedContentRepo) Create(ctx context.Context, content *biz.Content) (int64, error) {
+	id, err := c.contentRepo.Create(ctx, content)
+	if err != nil {
+		return 0, err
+	}
+	// 清除可能存在的不存在标记
+	c.invalidate(ctx, content.ContentID)
+	return id, nil
+}
+
+func (c *cachedContentRepo) BatchCreate(ctx context.Context, contents []*biz.Content) ([]int64, error) {
+	ids, err := c.contentRepo.BatchCreate(ctx, contents)
+	if err != nil {
+		return nil, err
+	}
+	contentIDs := make([]string, 0, len(contents))
+	for _, content := range contents {
+		contentIDs = append(contentIDs, content.ContentID)
+	}
+	c.invalidate(ctx, contentIDs...)
+	return ids, nil
+}
+
+func (c *cachedContentRepo) Update(ctx context.Context, id int64, content *biz.Content) error {
+	contentID, err := c.contentID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if err := c.contentRepo.Update(ctx, id, content); err != nil {
+		return err
+	}
+	c.invalidate(ctx, contentID)
+	return nil
+}
+
+func (c *cachedContentRepo) UpdateFields(ctx context.Context, id int64, content *biz.Content, fields []string) error {
+	contentID, err := c.contentID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if err := c.contentRepo.UpdateFields(ctx, id, content, fields); err != nil {
+		return err
+	}
+	c.invalidate(ctx, contentID)
+	return nil
+}
+
+func (c *cachedContentRepo) Delete(ctx context.Context, id int64, version int64) error {
+	// 删除后索引表中不再有 content_id, 需要提前查出
+	contentID, err := c.contentID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if err := c.contentRepo.Delete(ctx, id, version); err != nil {
+		return err
+	}
+	c.invalidate(ctx, contentID)
+	return nil
+}
+
+func (c *cachedContentRepo) Restore(ctx context.Context, id int64) error {
+	if err := c.contentRepo.Restore(ctx, id); err != nil {
+		return err
+	}
+	contentID, err := c.contentID(ctx, id)
+	if err != nil {
+		// 已经恢复成功, 不存在标记最多保留 negative_ttl
+		c.log.WithContext(ctx).Errorf("content cache restore id = %d error = %v", id, err)
+		return nil
+	}
+	// 清除删除期间写入的不存在标记
+	c.invalidate(ctx, contentID)
+	return nil
+}
+
+// contentID 查询索引中的 content_id, 包括回收站中的内容
+func (c *cachedContentRepo) contentID(ctx context.Context, id int64) (string, error) {
+	var idx IdxContentDetail
+	err := c.data.DB(ctx).Unscoped().Select("content_id").Where("id = ?", id).First(&idx).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return "", biz.ErrContentNotFound
+	}
+	if err != nil {
+		return "", err
+	}
+	return idx.ContentID, nil
+}
+
+// invalidate 在事务提交后删除缓存, 避免提交前被并发读取重新写入旧数据
+func (c *cachedContentRepo) invalidate(ctx context.Context, contentIDs ...string) {
+	keys := make([]string, 0, len(contentIDs))
+	for _, contentID := range contentIDs {
+		keys = append(keys, cacheKey(contentID))
+	}
+	c.data.afterCommit(ctx, func() {
+		if err := c.rdb.Del(context.WithoutCancel(ctx), keys...).Err(); err != nil {
+			c.log.WithContext(ctx).Errorf("content cache invalidate content_id = %v error = %v", contentIDs, err)
+		}
+	})
+}
+
+func (c *cachedContentRepo) First(ctx context.Context, idx *biz.ContentIndex) (*biz.Content, error) {
+	// 事务中需要读到未提交的数据, 不走缓存
+	if c.data.inTx(ctx) {
+		return c.contentRepo.First(ctx, idx)
+	}
+	key := cacheKey(idx.ContentID)
+	val, err := c.rdb.Get(ctx, key).Result()
+	if err == nil {
+		detail, err := decodeDetail(val)
+		if err == nil || errors.Is(err, gorm.ErrRecordNotFound) {
+			contentCacheRequests.WithLabelValues("first", "hit").Inc()
+			if err != nil {
+				return nil, biz.ErrContentNotFound
+			}
+			return toBizContent(idx, detail), nil
+		}
+		c.log.WithContext(ctx).Errorf("content cache decode content_id = %s error = %v", idx.ContentID, err)
+	} else if !errors.Is(err, redis.Nil) {
+		c.log.WithContext(ctx).Errorf("content cache get content_id = %s error = %v", idx.ContentID, err)
+	}
+	contentCacheRequests.WithLabelValues("first", "miss").Inc()
+
+	// 同一 content_id 的并发回源只查询一次数据库; 回源读主库, 避免把从库上的旧数据写入缓存
+	v, err, _ := c.group.Do(key, func() (interface{}, error) {
+		ctx := biz.WithPrimary(context.WithoutCancel(ctx))
+		detail, err := c.firstDetail(ctx, idx.ContentID)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			c.set(ctx, map[string]*ContentDetail{idx.ContentID: nil})
+			return nil, err
+		}
+		if err != nil {
+			return nil, err
+		}
+		c.set(ctx, map[string]*ContentDetail{idx.ContentID: detail})
+		return detail, nil
+	})
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, biz.ErrContentNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return toBizContent(idx, v.(*ContentDetail)), nil
+}
+
+func (c *cachedContentRepo) FindByContentIDs(ctx context.Context, indices []*biz.ContentIndex) ([]*biz.Content, error) {
+	if c.data.inTx(ctx) || len(indices) == 0 {
+		return c.contentRepo.FindByContentIDs(ctx, indices)
+	}
+	keys := make([]string, 0, len(indices))
+	for _, idx := range indices {
+		keys = append(keys, cacheKey(idx.ContentID))
+	}
+	details := make(map[string]*ContentDetail, len(indices))
+	var missing []string
+	vals, err := c.rdb.MGet(ctx, keys...).Result()
+	if err != nil {
+		c.log.WithContext(ctx).Errorf("content cache mget error = %v", err)
+		vals = make([]interface{}, len(indices))
+	}
+	for i, idx := range indices {
+		val, ok := vals[i].(string)
+		if !ok {
+			missing = append(missing, idx.ContentID)
+			continue
+		}
+		detail, err := decodeDetail(val)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			continue
+		}
+		if err != nil {
+			c.log.WithContext(ctx).Errorf("content cache decode content_id = %s error = %v", idx.ContentID, err)
+			missing = append(missing, idx.ContentID)
+			continue
+		}
+		details[idx.ContentID] = detail
+	}
+	contentCacheRequests.WithLabelValues("batch", "hit").Add(float64(len(indices) - len(missing)))
+	contentCacheRequests.WithLabelValues("batch", "miss").Add(float64(len(missing)))
+
+	var partial error
+	if len(missing) > 0 {
+		loaded, unavailable, err := c.findDetails(biz.WithPrimary(ctx), missing, false)
+		if err != nil {
+			return nil, err
+		}
+		partial = partialError(unavailable)
+		fill := make(map[string]*ContentDetail, len(missing))
+		for _, contentID := range missing {
+			// 分片库不可用时没有查询结果, 不能写入不存在标记
+			if unavailable[contentID] {
+				continue
+			}
+			// 未查到的记为 nil, 写入不存在标记
+			fill[contentID] = loaded[contentID]
+			if detail, ok := loaded[contentID]; ok {
+				details[contentID] = detail
+			}
+		}
+		c.set(ctx, fill)
+	}
+
+	contents := make([]*biz.Content, 0, len(indices))
+	for _, idx := range indices {
+		if detail, ok := details[idx.ContentID]; ok {
+			contents = append(contents, toBizContent(idx, detail))
+		}
+	}
+	return contents, partial
+}
+
+// set 写入缓存, detail 为 nil 时写入不存在标记; 写缓存失败不影响读取结果
+func (c *cachedContentRepo) set(ctx context.Context, details map[string]*ContentDetail) {
+	pipe := c.rdb.Pipeline()
+	for contentID, detail := range details {
+		if detail == nil {
+			pipe.Set(ctx, cacheKey(contentID), contentCacheMissing, c.negativeTTL)
+			continue
+		}
+		data, err := json.Marshal(detail)
+		if err != nil {
+			c.log.WithContext(ctx).Errorf("content cache marshal content_id = %s error = %v", contentID, err)
+			continue
+		}
+		pipe.Set(ctx, cacheKey(contentID), data, c.ttl)
+	}
+	if _, err := pipe.Exec(ctx); err != nil {
+		c.log.WithContext(ctx).Errorf("content cache set error = %v", err)
+	}
+}
+
+// decodeDetail 解析缓存值, 不存在标记返回 gorm.ErrRecordNotFound, 与数据库查询结果一致
+func decodeDetail(val string) (*ContentDetail, error) {
+	if val == contentCacheMissing {
+		return nil, gorm.ErrRecordNotFound
+	}
+	var detail ContentDetail
+	if err := json.Unmarshal([]byte(val), &detail); err != nil {
+		return nil, err
+	}
+	return &detail, nil
+}
+
+var _ biz.ContentRepo = (*cachedContentRepo)(nil)
@@ -0,0 +1,212 @@
+package data
+
+import (
+	"content_manage/api/operate"
+	"content_manage/internal/biz"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/redis/go-redis/v9"
+)
+
+func newTestCache(t *testing.T) (*cachedContentRepo, *miniredis.Miniredis) {
+	t.Helper()
+	mr := miniredis.RunT(t)
+	rdb := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	t.Cleanup(func() { rdb.Close() })
+	d := newTestData(t, rdb)
+	repo := &contentRepo{data: d, log: log.NewHelper(log.NewStdLogger(io.Discard))}
+	return newCachedContentRepo(repo, rdb, time.Minute, 10*time.Second), mr
+}
+
+// createTestContent 写入一条内容, 返回其索引
+func createTestContent(t *testing.T, repo biz.ContentRepo, id int64) *biz.ContentIndex {
+	t.Helper()
+	content := &biz.Content{
+		ID:        id,
+		ContentID: fmt.Sprintf("content-%d", id),
+		Title:     "title",
+		VideoURL:  "https://example.com/v.mp4",
+		Author:    "author",
+	}
+	if _, err := repo.Create(context.Background(), content); err != nil {
+		t.Fatal(err)
+	}
+	return &biz.ContentIndex{ID: id, ContentID: content.ContentID}
+}
+
+func TestCachedContentRepoFirst(t *testing.T) {
+	repo, mr := newTestCache(t)
+	ctx := context.Background()
+	idx := createTestContent(t, repo, 1)
+
+	// 未命中时回源并写入缓存
+	content, err := repo.First(ctx, idx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if content.Title != "title" {
+		t.Fatalf("First() title = %q, want %q", content.Title, "title")
+	}
+	if !mr.Exists(cacheKey(idx.ContentID)) {
+		t.Fatal("First() did not fill the cache")
+	}
+	if ttl := mr.TTL(cacheKey(idx.ContentID)); ttl != time.Minute {
+		t.Fatalf("cache ttl = %s, want %s", ttl, time.Minute)
+	}
+
+	// 绕过 repo 修改数据库, 命中时仍返回缓存中的内容
+	table := repo.data.router.Table(idx.ContentID)
+	if err := repo.data.db.Table(table).Where("content_id = ?", idx.ContentID).
+		Update("title", "changed").Error; err != nil {
+		t.Fatal(err)
+	}
+	content, err = repo.First(ctx, idx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if content.Title != "title" {
+		t.Fatalf("First() title = %q, want the cached %q", content.Title, "title")
+	}
+}
+
+func TestCachedContentRepoNegative(t *testing.T) {
+	repo, mr := newTestCache(t)
+	ctx := context.Background()
+	idx := &biz.ContentIndex{ID: 1, ContentID: "content-1"}
+
+	if _, err := repo.First(ctx, idx); !errors.Is(err, biz.ErrContentNotFound) {
+		t.Fatalf("First() error = %v, want ErrContentNotFound", err)
+	}
+	val, err := mr.Get(cacheKey(idx.ContentID))
+	if err != nil || val != contentCacheMissing {
+		t.Fatalf("cache value = %q, %v, want the missing marker", val, err)
+	}
+	if ttl := mr.TTL(cacheKey(idx.ContentID)); ttl != 10*time.Second {
+		t.Fatalf("missing marker ttl = %s, want %s", ttl, 10*time.Second)
+	}
+
+	// 批量读取同样命中不存在标记
+	contents, err := repo.FindByContentIDs(ctx, []*biz.ContentIndex{idx})
+	if err != nil || len(contents) != 0 {
+		t.Fatalf("FindByContentIDs() = %d contents, %v, want none", len(contents), err)
+	}
+
+	// 创建后清除不存在标记
+	createTestContent(t, repo, 1)
+	if mr.Exists(cacheKey(idx.ContentID)) {
+		t.Fatal("Create() did not clear the missing marker")
+	}
+	if _, err := repo.First(ctx, idx); err != nil {
+		t.Fatalf("First() after Create() error = %v", err)
+	}
+}
+
+func TestCachedContentRepoInvalidate(t *testing.T) {
+	repo, mr := newTestCache(t)
+	ctx := context.Background()
+	idx := createTestContent(t, repo, 1)
+	key := cacheKey(idx.ContentID)
+	update := &biz.Content{Title: "changed"}
+
+	if _, err := repo.First(ctx, idx); err != nil {
+		t.Fatal(err)
+	}
+	// 事务回滚时不删除缓存
+	rollback := errors.New("rollback")
+	err := repo.ExecTx(ctx, func(ctx context.Context) error {
+		if err := repo.UpdateFields(ctx, idx.ID, update, []string{"title"}); err != nil {
+			return err
+		}
+		return rollback
+	})
+	if !errors.Is(err, rollback) {
+		t.Fatalf("ExecTx() error = %v, want %v", err, rollback)
+	}
+	if !mr.Exists(key) {
+		t.Fatal("cache invalidated after rollback")
+	}
+
+	// 事务提交后才删除缓存
+	err = repo.ExecTx(ctx, func(ctx context.Context) error {
+		if err := repo.UpdateFields(ctx, idx.ID, update, []string{"title"}); err != nil {
+			return err
+		}
+		if !mr.Exists(key) {
+			t.Error("cache invalidated before commit")
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if mr.Exists(key) {
+		t.Fatal("cache not invalidated after commit")
+	}
+	content, err := repo.First(ctx, idx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if content.Title != "changed" {
+		t.Fatalf("First() title = %q, want %q", content.Title, "changed")
+	}
+
+	// 删除后同样失效
+	if _, err := repo.First(ctx, idx); err != nil {
+		t.Fatal(err)
+	}
+	if err := repo.Delete(ctx, idx.ID, 0); err != nil {
+		t.Fatal(err)
+	}
+	if mr.Exists(key) {
+		t.Fatal("cache not invalidated after Delete()")
+	}
+}
+
+func TestCachedContentRepoShardUnavailable(t *testing.T) {
+	repo, mr := newTestCache(t)
+	ctx := context.Background()
+	idx := &biz.ContentIndex{ID: 1, ContentID: "content-1"}
+	// 详情表所在的分片库不可用
+	down := &shardDB{name: "shard_a", db: repo.data.db}
+	down.setUp(false)
+	repo.data.tableDBs[repo.data.router.Table(idx.ContentID)] = down
+
+	if _, err := repo.First(ctx, idx); !operate.IsShardUnavailable(err) {
+		t.Fatalf("First() error = %v, want SHARD_UNAVAILABLE", err)
+	}
+	contents, err := repo.FindByContentIDs(ctx, []*biz.ContentIndex{idx})
+	var partial *biz.PartialError
+	if !errors.As(err, &partial) || len(partial.ContentIDs) != 1 || partial.ContentIDs[0] != idx.ContentID {
+		t.Fatalf("FindByContentIDs() error = %v, want a partial error for %s", err, idx.ContentID)
+	}
+	if len(contents) != 0 {
+		t.Fatalf("FindByContentIDs() = %d contents, want none", len(contents))
+	}
+	if mr.Exists(cacheKey(idx.ContentID)) {
+		t.Fatal("missing marker written while the shard is unavailable")
+	}
+}
+
+func TestContentRepoNotFound(t *testing.T) {
+	cached, _ := newTestCache(t)
+	ctx := context.Background()
+	// 带缓存和不带缓存的实现都不返回 gorm.ErrRecordNotFound
+	for name, repo := range map[string]biz.ContentRepo{"contentRepo": cached.contentRepo, "cachedContentRepo": cached} {
+		if err := repo.Update(ctx, 404, &biz.Content{Title: "title"}); !errors.Is(err, biz.ErrContentNotFound) {
+			t.Fatalf("%s Update() error = %v, want ErrContentNotFound", name, err)
+		}
+		if err := repo.UpdateFields(ctx, 404, &biz.Content{Title: "title"}, []string{"title"}); !errors.Is(err, biz.ErrContentNotFound) {
+			t.Fatalf("%s UpdateFields() error = %v, want ErrContentNotFound", name, err)
+		}
+		if err := repo.Delete(ctx, 404, 0); !errors.Is(err, biz.ErrContentNotFound) {
+			t.Fatalf("%s Delete() error = %v, want ErrContentNotFound", name, err)
+		}
+	}
+}
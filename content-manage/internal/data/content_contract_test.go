@@ -0,0 +1,73 @@
+package data
+
+import (
+	"content_manage/api/operate"
+	"content_manage/internal/service"
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/encoding/prototext"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// TestContentDurationContract content-system 转换的请求写入后按原样读回时长, 请求见 testdata/create_content.txtpb
+func TestContentDurationContract(t *testing.T) {
+	golden, err := os.ReadFile("testdata/create_content.txtpb")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var req operate.CreateContentReq
+	if err := prototext.Unmarshal(golden, &req); err != nil {
+		t.Fatal(err)
+	}
+	uc, _ := newTestUsecase(t)
+	app := service.NewAppService(uc)
+	ctx := context.Background()
+	want := 90*time.Minute + time.Nanosecond
+
+	created, err := app.CreateContent(ctx, &req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := created.GetContent().GetDuration().AsDuration(); got != want {
+		t.Fatalf("created duration = %s, want %s", got, want)
+	}
+
+	rsp, err := app.FindContent(ctx, &operate.FindContentReq{Id: created.GetContent().GetId()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rsp.GetContents()) != 1 {
+		t.Fatalf("found %d contents, want 1", len(rsp.GetContents()))
+	}
+	if got := rsp.GetContents()[0].GetDuration().AsDuration(); got != want {
+		t.Fatalf("found duration = %s, want %s", got, want)
+	}
+
+	// 索引中的时长与详情一致, 按时长过滤时纳秒精度不丢失
+	for _, tc := range []struct {
+		min, max time.Duration
+		found    bool
+	}{
+		{min: want, max: want, found: true},
+		{min: want + time.Nanosecond, found: false},
+		{max: want - time.Nanosecond, found: false},
+	} {
+		req := &operate.FindContentReq{}
+		if tc.min > 0 {
+			req.MinDuration = durationpb.New(tc.min)
+		}
+		if tc.max > 0 {
+			req.MaxDuration = durationpb.New(tc.max)
+		}
+		rsp, err := app.FindContent(ctx, req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if found := len(rsp.GetContents()) == 1; found != tc.found {
+			t.Fatalf("FindContent(min = %s, max = %s) found = %v, want %v", tc.min, tc.max, found, tc.found)
+		}
+	}
+}
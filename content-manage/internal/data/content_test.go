@@ -0,0 +1,258 @@
+package data
+
+import (
+	"content_manage/api/operate"
+	"content_manage/internal/biz"
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"testing"
+	"time"
+)
+
+func TestUpdateContentStaleVersion(t *testing.T) {
+	uc, _ := newTestUsecase(t)
+	ctx := context.Background()
+	id := createUsecaseContent(t, uc, "alpha")
+	// 其他人先基于版本 1 完成修改
+	if _, err := uc.UpdateContent(ctx, &biz.Content{ID: id, Title: "bravo", Version: 1}, nil, "alice"); err != nil {
+		t.Fatal(err)
+	}
+
+	// 仍基于版本 1 的修改和删除被拒绝
+	if _, err := uc.UpdateContent(ctx, &biz.Content{ID: id, Title: "charlie", Version: 1}, nil, "bob"); !operate.IsContentConflict(err) {
+		t.Fatalf("UpdateContent() with a stale version error = %v, want CONTENT_CONFLICT", err)
+	}
+	if _, err := uc.UpdateContent(ctx, &biz.Content{ID: id, Category: "news", Version: 1}, []string{"category"}, "bob"); !operate.IsContentConflict(err) {
+		t.Fatalf("UpdateContent() of listed fields with a stale version error = %v, want CONTENT_CONFLICT", err)
+	}
+	if err := uc.DeleteContent(ctx, id, 1); !operate.IsContentConflict(err) {
+		t.Fatalf("DeleteContent() with a stale version error = %v, want CONTENT_CONFLICT", err)
+	}
+
+	// 被拒绝的修改不生效, 也不记录版本
+	content, err := uc.GetContent(ctx, id, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if content.Title != "bravo" || content.Category != "" || content.Version != 2 {
+		t.Fatalf("content = title %q, category %q, version %d, want bravo, empty, 2", content.Title, content.Category, content.Version)
+	}
+	if _, total, err := uc.ListRevisions(ctx, id, 1, 10); err != nil || total != 2 {
+		t.Fatalf("ListRevisions() total = %d, %v, want 2", total, err)
+	}
+
+	// 基于当前版本的修改成功
+	updated, err := uc.UpdateContent(ctx, &biz.Content{ID: id, Title: "charlie", Version: 2}, nil, "bob")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if updated.Title != "charlie" || updated.Version != 3 {
+		t.Fatalf("UpdateContent() = title %q, version %d, want charlie, 3", updated.Title, updated.Version)
+	}
+}
+
+// createFindContents 通过 uc 创建 n 条内容, 标题为 content-<i>, setup 可修改第 i 条内容的字段
+func createFindContents(t *testing.T, uc *biz.ContentUsecase, n int, setup func(i int, c *biz.Content)) []int64 {
+	t.Helper()
+	ids := make([]int64, n)
+	for i := range ids {
+		c := newTestContent(fmt.Sprintf("content-%d", i))
+		if setup != nil {
+			setup(i, c)
+		}
+		id, _, err := uc.CreateContent(context.Background(), c, "")
+		if err != nil {
+			t.Fatal(err)
+		}
+		ids[i] = id
+	}
+	return ids
+}
+
+func TestFindContentOrder(t *testing.T) {
+	uc, d := newTestUsecase(t)
+	ctx := context.Background()
+	// 文件大小与创建顺序无关, 内容分散在多张详情表中
+	const n = 12
+	createFindContents(t, uc, n, func(i int, c *biz.Content) {
+		c.FileSize = int64(i*7%n + 1)
+	})
+
+	contents, total, _, err := uc.FindContent(ctx, &biz.FindParams{SortBy: biz.SortByFileSize, Desc: true, PageSize: n})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if total != n || len(contents) != n {
+		t.Fatalf("FindContent() = %d contents, total %d, want %d", len(contents), total, n)
+	}
+	tables := make(map[string]bool)
+	for i, c := range contents {
+		// 按详情表分组读取后仍按索引的排序返回, 且每条详情对应自己的索引
+		if want := int64(n - i); c.FileSize != want {
+			t.Fatalf("FindContent() content %d file size = %d, want %d", i, c.FileSize, want)
+		}
+		var idx IdxContentDetail
+		if err := d.db.Where("id = ?", c.ID).First(&idx).Error; err != nil {
+			t.Fatal(err)
+		}
+		if idx.ContentID != c.ContentID || idx.Title != c.Title {
+			t.Fatalf("FindContent() content %d = %s %q, want the detail of %s %q", c.ID, c.ContentID, c.Title, idx.ContentID, idx.Title)
+		}
+		tables[d.router.Table(c.ContentID)] = true
+	}
+	if len(tables) < 2 {
+		t.Fatalf("contents are in %d detail table, want several", len(tables))
+	}
+}
+
+// findAll 按 params 的排序以 pageSize 逐页读取全部内容, 返回内容 id
+func findAll(t *testing.T, uc *biz.ContentUsecase, params biz.FindParams, pageSize int32) []int64 {
+	t.Helper()
+	var ids []int64
+	for page := 0; ; page++ {
+		if page > 100 {
+			t.Fatal("FindContent() did not stop paging")
+		}
+		p := params
+		p.PageSize = pageSize
+		contents, _, next, err := uc.FindContent(context.Background(), &p)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, c := range contents {
+			ids = append(ids, c.ID)
+		}
+		if next == "" {
+			return ids
+		}
+		params.PageToken = next
+	}
+}
+
+func TestFindContentCursor(t *testing.T) {
+	uc, d := newTestUsecase(t)
+	ctx := context.Background()
+	// 每 3 条内容的排序值相同, 翻页边界落在相同的值中间
+	const n = 8
+	ids := createFindContents(t, uc, n, func(i int, c *biz.Content) {
+		c.Duration = time.Duration(i/3) * time.Minute
+	})
+	base := time.Now().Truncate(time.Second)
+	for i, id := range ids {
+		at := base.Add(time.Duration(n-i/3) * time.Millisecond)
+		if err := d.db.Model(&IdxContentDetail{}).Where("id = ?", id).
+			Updates(map[string]interface{}{"created_at": at, "updated_at": at}).Error; err != nil {
+			t.Fatal(err)
+		}
+	}
+	// sortKey 第 i 条内容的排序值
+	sortKey := map[biz.SortBy]func(i int) int{
+		biz.SortByCreatedAt: func(i int) int { return n - i/3 },
+		biz.SortByUpdatedAt: func(i int) int { return n - i/3 },
+		biz.SortByDuration:  func(i int) int { return i / 3 },
+	}
+
+	for sortBy, key := range sortKey {
+		for _, desc := range []bool{false, true} {
+			want := make([]int, n)
+			for i := range want {
+				want[i] = i
+			}
+			// 相同排序值按 id 排序, 方向与排序值一致
+			sort.Slice(want, func(a, b int) bool {
+				ka, kb := key(want[a]), key(want[b])
+				if ka != kb {
+					return (ka < kb) != desc
+				}
+				return (ids[want[a]] < ids[want[b]]) != desc
+			})
+			wantIDs := make([]int64, n)
+			for i, w := range want {
+				wantIDs[i] = ids[w]
+			}
+			for _, pageSize := range []int32{1, 2, 3, n} {
+				got := findAll(t, uc, biz.FindParams{SortBy: sortBy, Desc: desc, SkipTotal: true}, pageSize)
+				if !equalIDs(got, wantIDs) {
+					t.Fatalf("FindContent(sort %d, desc %t, page size %d) ids = %v, want %v", sortBy, desc, pageSize, got, wantIDs)
+				}
+			}
+		}
+	}
+
+	// 游标只能用于生成它的排序
+	_, _, next, err := uc.FindContent(ctx, &biz.FindParams{SortBy: biz.SortByDuration, PageSize: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, _, _, err := uc.FindContent(ctx, &biz.FindParams{SortBy: biz.SortByDuration, Desc: true, PageSize: 2, PageToken: next}); !errors.Is(err, biz.ErrInvalidPageToken) {
+		t.Fatalf("FindContent() with the token of another order error = %v, want ErrInvalidPageToken", err)
+	}
+}
+
+func TestFindContentFilters(t *testing.T) {
+	uc, d := newTestUsecase(t)
+	ctx := context.Background()
+	fixtures := []biz.Content{
+		{Title: "go intro", Author: "alice", Category: "news", ApprovalStatus: 1, Quality: 1, Format: "mp4", Resolution: "1080p", Duration: time.Minute},
+		{Title: "go advanced", Author: "bob", Category: "news", ApprovalStatus: 2, Quality: 2, Format: "mp4", Resolution: "720p", Duration: 5 * time.Minute},
+		{Title: "football", Author: "alice", Category: "sport", ApprovalStatus: 1, Quality: 2, Format: "mkv", Resolution: "1080p", Duration: 10 * time.Minute},
+		{Title: "go karts", Author: "bob", Category: "sport", ApprovalStatus: 3, Quality: 1, Format: "mp4", Resolution: "1080p", Duration: 30 * time.Minute},
+		{Title: "weather", Author: "alice", Category: "news", ApprovalStatus: 3, Quality: 2, Format: "mkv", Resolution: "720p", Duration: time.Hour},
+	}
+	ids := createFindContents(t, uc, len(fixtures), func(i int, c *biz.Content) {
+		f := fixtures[i]
+		c.Title, c.Author, c.Category = f.Title, f.Author, f.Category
+		c.ApprovalStatus, c.Quality, c.Format, c.Resolution, c.Duration = f.ApprovalStatus, f.Quality, f.Format, f.Resolution, f.Duration
+	})
+	base := time.Now().Truncate(time.Second)
+	for i, id := range ids {
+		if err := d.db.Model(&IdxContentDetail{}).Where("id = ?", id).
+			Update("created_at", base.Add(time.Duration(i)*time.Hour)).Error; err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tests := []struct {
+		name   string
+		params biz.FindParams
+		want   []int
+	}{
+		{"category", biz.FindParams{Category: "news"}, []int{0, 1, 4}},
+		{"category and approval status", biz.FindParams{Category: "news", ApprovalStatus: []int32{1, 3}}, []int{0, 4}},
+		{"approval status and quality", biz.FindParams{ApprovalStatus: []int32{1, 2}, Quality: 2}, []int{1, 2}},
+		{"format and resolution", biz.FindParams{Format: "mp4", Resolution: "1080p"}, []int{0, 3}},
+		{"duration range", biz.FindParams{MinDuration: 5 * time.Minute, MaxDuration: 30 * time.Minute}, []int{1, 2, 3}},
+		{"duration range and category", biz.FindParams{Category: "sport", MinDuration: 5 * time.Minute, MaxDuration: 30 * time.Minute}, []int{2, 3}},
+		{"title and author", biz.FindParams{Title: "go", Author: "bob"}, []int{1, 3}},
+		{"created window", biz.FindParams{CreatedAfter: base.Add(time.Hour), CreatedBefore: base.Add(3 * time.Hour)}, []int{1, 2}},
+		{"created window and format", biz.FindParams{Format: "mkv", CreatedAfter: base.Add(time.Hour)}, []int{2, 4}},
+		{"no match", biz.FindParams{Category: "sport", Author: "alice", Format: "mp4"}, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			params := tt.params
+			params.PageSize = 2
+			contents, total, _, err := uc.FindContent(ctx, &params)
+			if err != nil {
+				t.Fatal(err)
+			}
+			// 总数不受分页影响
+			if total != int64(len(tt.want)) {
+				t.Fatalf("FindContent() total = %d, want %d", total, len(tt.want))
+			}
+			want := make([]int64, 0, len(tt.want))
+			for _, i := range tt.want {
+				want = append(want, ids[i])
+			}
+			got := findAll(t, uc, tt.params, 2)
+			if !equalIDs(got, want) {
+				t.Fatalf("FindContent() ids = %v, want %v", got, want)
+			}
+			if len(contents) > 0 && contents[0].ID != want[0] {
+				t.Fatalf("FindContent() first id = %d, want %d", contents[0].ID, want[0])
+			}
+		})
+	}
+}
@@ -1,42 +1,288 @@
 package data
 
 import (
+	"content_manage/internal/biz"
 	"content_manage/internal/conf"
+	"context"
+	"errors"
+	"sync"
 
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/google/wire"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/redis/go-redis/v9"
+	shard "github.com/zerokkcoder/content-shard"
+	"google.golang.org/protobuf/types/known/durationpb"
 	"gorm.io/driver/mysql"
 	"gorm.io/gorm"
 )
 
 // ProviderSet is data providers.
-var ProviderSet = wire.NewSet(NewData, NewContentRepo)
+var ProviderSet = wire.NewSet(NewData, NewShardRouter, NewContentRepo, NewReshardRepo, NewSearchRepo, NewRevisionRepo, NewIdempotencyRepo, NewOutboxRepo, NewFlowTrigger, NewEventBus, NewEtcdClient, NewIDGenerator)
 
 // Data .
 type Data struct {
-	db *gorm.DB
+	db     *gorm.DB
+	router *shard.Router
+	// 内容详情缓存, 未配置 redis 时为 nil
+	rdb *redis.Client
+	// 进行中的重分片, 未配置目标布局时为 nil
+	reshard *reshardState
+	// 详情表所在的分片库, 不在其中的表与索引表同在主库
+	tableDBs map[string]*shardDB
+	shardDBs map[string]*shardDB
+	// 主库的从库, 未配置时为 nil
+	replicas *replicaSet
+	log      *log.Helper
+}
+
+// NewShardRouter 根据配置创建详情表分片路由
+func NewShardRouter(c *conf.Data) (*shard.Router, error) {
+	return newRouter(c.GetShard())
+}
+
+func newRouter(sc *conf.Data_Shard) (*shard.Router, error) {
+	return shard.New(shard.Config{
+		Strategy: shard.Strategy(sc.GetStrategy()),
+		Shards:   int(sc.GetShards()),
+		Table:    sc.GetTable(),
+		Replicas: int(sc.GetReplicas()),
+		Ranges:   sc.GetRanges(),
+		Version:  sc.GetVersion(),
+	})
 }
 
 // NewData .
-func NewData(c *conf.Data, logger log.Logger) (*Data, func(), error) {
+func NewData(c *conf.Data, router *shard.Router, logger log.Logger) (*Data, func(), error) {
+	shardDBs, err := openShardDBs(c)
+	if err != nil {
+		return nil, nil, err
+	}
+	replicas, err := openReplicas(c.GetDatabase())
+	if err != nil {
+		closeShardDBs(shardDBs)
+		return nil, nil, err
+	}
+	mysqlDB, err := gorm.Open(mysql.Open(c.GetDatabase().GetSource()), &gorm.Config{})
+	if err == nil {
+		pool := c.GetDatabase().GetPrimaryPool()
+		err = setPool(mysqlDB, "primary", pool.GetMaxOpenConns(), pool.GetMaxIdleConns(), pool.GetConnMaxLifetime())
+	}
+	if err != nil {
+		closeDB(mysqlDB)
+		closeShardDBs(shardDBs)
+		if replicas != nil {
+			replicas.close()
+		}
+		return nil, nil, err
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	var rdb *redis.Client
+	if rc := c.GetRedis(); rc.GetAddr() != "" {
+		rdb = redis.NewClient(&redis.Options{
+			Network:      rc.GetNetwork(),
+			Addr:         rc.GetAddr(),
+			ReadTimeout:  rc.GetReadTimeout().AsDuration(),
+			WriteTimeout: rc.GetWriteTimeout().AsDuration(),
+		})
+	}
 	cleanup := func() {
+		cancel()
+		if rdb != nil {
+			rdb.Close()
+		}
+		closeShardDBs(shardDBs)
+		if replicas != nil {
+			replicas.close()
+		}
+		closeDB(mysqlDB)
 		log.NewHelper(logger).Info("closing the data resources")
 	}
 
-	mysqlDB, err := gorm.Open(mysql.Open(c.GetDatabase().GetSource()), &gorm.Config{})
+	if replicas != nil {
+		go replicas.watch(ctx, log.NewHelper(logger))
+	}
+
+	log.NewHelper(logger).Infof("shard topology version = %s", router.Version())
+
+	reshard, err := newReshardState(c, router)
 	if err != nil {
-		panic(err)
+		cleanup()
+		return nil, nil, err
+	}
+	tableDBs := make(map[string]*shardDB)
+	if err := mapShardTables(tableDBs, shardDBs, router, c.GetShard().GetDatabases()); err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	if reshard != nil {
+		if err := mapShardTables(tableDBs, shardDBs, reshard.target, c.GetReshard().GetTarget().GetDatabases()); err != nil {
+			cleanup()
+			return nil, nil, err
+		}
+	}
+	if len(shardDBs) > 0 {
+		interval := defaultHealthCheckInterval
+		if c.GetHealthCheckInterval() != nil {
+			interval = c.GetHealthCheckInterval().AsDuration()
+		}
+		go watchShardDBs(ctx, shardDBs, interval, log.NewHelper(logger))
+	}
+	if reshard != nil {
+		if err := reshard.refresh(ctx, mysqlDB); err != nil {
+			cleanup()
+			return nil, nil, err
+		}
+		go reshard.watch(ctx, mysqlDB, log.NewHelper(logger))
+	}
+
+	d := &Data{
+		db:       mysqlDB,
+		router:   router,
+		rdb:      rdb,
+		reshard:  reshard,
+		tableDBs: tableDBs,
+		shardDBs: shardDBs,
+		replicas: replicas,
+		log:      log.NewHelper(logger),
+	}
+	if len(shardDBs) > 0 {
+		go d.watchShardRepairs(ctx, log.NewHelper(logger))
+	}
+	return d, cleanup, nil
+}
+
+// closeDB 关闭 gorm 底层的连接池, db 为 nil 时忽略
+func closeDB(db *gorm.DB) {
+	if db == nil {
+		return
+	}
+	if sqlDB, err := db.DB(); err == nil {
+		sqlDB.Close()
 	}
+}
+
+type contextTxKey struct{}
 
-	db, err := mysqlDB.DB()
+type contextHooksKey struct{}
+
+// txHooks 事务提交后执行的回调
+type txHooks struct {
+	mu  sync.Mutex
+	fns []func()
+}
+
+// ExecTx 在一个事务中执行 fn, fn 内使用传入的 ctx 调用 DB 时会加入该事务
+func (d *Data) ExecTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	// 已经处于事务中, 直接加入外层事务
+	if _, ok := ctx.Value(contextTxKey{}).(*gorm.DB); ok {
+		return fn(ctx)
+	}
+	hooks := &txHooks{}
+	txs := &shardTxs{}
+	var repair *ShardRepair
+	err := d.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		ctx := context.WithValue(ctx, contextHooksKey{}, hooks)
+		ctx = context.WithValue(ctx, contextShardTxKey{}, txs)
+		if err := fn(context.WithValue(ctx, contextTxKey{}, tx)); err != nil {
+			txs.rollback()
+			return err
+		}
+		rows := txs.modified()
+		if len(rows) == 0 {
+			return txs.commit()
+		}
+		// 分片库先于主库提交, 两者之间失败时分片库的详情与主库的索引、版本和历史版本不一致;
+		// 提交前写入补偿记录, 主库未提交时由 finishRepair 或 watchShardRepairs 把详情恢复到修改前
+		var err error
+		if repair, err = d.prepareRepair(ctx, tx, rows); err != nil {
+			txs.rollback()
+			return err
+		}
+		return txs.commit()
+	})
+	if repair != nil {
+		d.finishRepair(ctx, repair, err == nil)
+	}
 	if err != nil {
-		panic(err)
+		return err
+	}
+	for _, fn := range hooks.fns {
+		fn()
+	}
+	return nil
+}
+
+// afterCommit 在 ctx 所在事务提交后执行 fn, 事务回滚时不执行; 不在事务中时立即执行
+func (d *Data) afterCommit(ctx context.Context, fn func()) {
+	hooks, ok := ctx.Value(contextHooksKey{}).(*txHooks)
+	if !ok {
+		fn()
+		return
+	}
+	hooks.mu.Lock()
+	defer hooks.mu.Unlock()
+	hooks.fns = append(hooks.fns, fn)
+}
+
+// inTx ctx 是否处于事务中
+func (d *Data) inTx(ctx context.Context) bool {
+	_, ok := ctx.Value(contextTxKey{}).(*gorm.DB)
+	return ok
+}
+
+// DB 返回 ctx 中绑定的事务, 没有事务时返回默认连接
+func (d *Data) DB(ctx context.Context) *gorm.DB {
+	if tx, ok := ctx.Value(contextTxKey{}).(*gorm.DB); ok {
+		return tx
 	}
+	return d.db.WithContext(ctx)
+}
+
+// ReadDB 返回只读查询使用的连接: 事务中和读己之写使用主库, 否则使用复制延迟在阈值内的从库, 没有时回退到主库
+func (d *Data) ReadDB(ctx context.Context) *gorm.DB {
+	if d.replicas == nil || d.inTx(ctx) || biz.IsPrimary(ctx) {
+		return d.DB(ctx)
+	}
+	if r := d.replicas.pick(); r != nil {
+		return r.db.WithContext(ctx)
+	}
+	replicaFallbacks.Inc()
+	return d.db.WithContext(ctx)
+}
 
-	db.SetMaxOpenConns(4)
-	db.SetMaxIdleConns(2)
+// openLazy 打开数据库但不查询服务端版本也不 ping, 库不可用时不影响服务启动, 由健康检查发现
+func openLazy(dsn string) (*gorm.DB, error) {
+	return gorm.Open(mysql.New(mysql.Config{
+		DSN:                       dsn,
+		SkipInitializeWithVersion: true,
+	}), &gorm.Config{DisableAutomaticPing: true})
+}
 
-	return &Data{
-		db: mysqlDB,
-	}, cleanup, nil
+// setPool 设置连接池, 未配置的项使用默认值, 并以 name 为 db_name 标签采集连接池统计
+func setPool(gdb *gorm.DB, name string, maxOpen, maxIdle int32, maxLifetime *durationpb.Duration) error {
+	db, err := gdb.DB()
+	if err != nil {
+		return err
+	}
+	if maxOpen <= 0 {
+		maxOpen = 4
+	}
+	if maxIdle <= 0 {
+		maxIdle = 2
+	}
+	db.SetMaxOpenConns(int(maxOpen))
+	db.SetMaxIdleConns(int(maxIdle))
+	if maxLifetime != nil {
+		db.SetConnMaxLifetime(maxLifetime.AsDuration())
+	}
+	// 重复创建 Data 时沿用已注册的采集器
+	if err := prometheus.Register(collectors.NewDBStatsCollector(db, name)); err != nil {
+		var registered prometheus.AlreadyRegisteredError
+		if !errors.As(err, &registered) {
+			return err
+		}
+	}
+	return nil
 }
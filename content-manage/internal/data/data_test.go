@@ -0,0 +1,200 @@
+package data
+
+import (
+	"content_manage/internal/biz"
+	"content_manage/internal/conf"
+	"context"
+	"database/sql"
+	"errors"
+	"io"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/mattn/go-sqlite3"
+	"github.com/redis/go-redis/v9"
+	shard "github.com/zerokkcoder/content-shard"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// testDriver 每个连接都把 <主库文件>.cms_content 附加为 cms_content 库, 表名与 MySQL 中一致
+const testDriver = "sqlite3_cms_content"
+
+func init() {
+	sql.Register(testDriver, &sqlite3.SQLiteDriver{
+		ConnectHook: func(conn *sqlite3.SQLiteConn) error {
+			_, err := conn.Exec("ATTACH DATABASE '"+conn.GetFilename("main")+".cms_content' AS cms_content", nil)
+			return err
+		},
+	})
+}
+
+// newTestDB 在临时目录中创建 sqlite 库, 包含主库的表和 router 的所有详情表
+func newTestDB(t *testing.T, router *shard.Router) *gorm.DB {
+	t.Helper()
+	dsn := filepath.Join(t.TempDir(), "main.db") + "?_busy_timeout=5000"
+	gdb, err := gorm.Open(sqlite.Dialector{DriverName: testDriver, DSN: dsn}, &gorm.Config{
+		Logger: logger.Discard,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	db, err := gdb.DB()
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { db.Close() })
+	if err := gdb.AutoMigrate(&IdxContentDetail{}, &ContentRevision{}, &IdempotencyKey{}, &OutboxMessage{}, &ShardRepair{}); err != nil {
+		t.Fatal(err)
+	}
+	for _, table := range router.Tables() {
+		if err := gdb.Table(table).AutoMigrate(&ContentDetail{}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return gdb
+}
+
+// newTestData 使用 sqlite 主库和默认分片路由的 Data, rdb 可以为 nil
+func newTestData(t *testing.T, rdb *redis.Client) *Data {
+	t.Helper()
+	router := shard.MustNew(shard.DefaultConfig())
+	return &Data{
+		db:       newTestDB(t, router),
+		router:   router,
+		rdb:      rdb,
+		tableDBs: make(map[string]*shardDB),
+		shardDBs: make(map[string]*shardDB),
+		log:      log.NewHelper(log.NewStdLogger(io.Discard)),
+	}
+}
+
+// testIDs 按顺序分配内容 id
+type testIDs struct {
+	next atomic.Int64
+}
+
+func (g *testIDs) NextID(ctx context.Context) (int64, error) {
+	return g.next.Add(1), nil
+}
+
+// newTestUsecase 在 newTestData 上组装 ContentUsecase, 全文索引只在内存中, 事件使用内存总线
+func newTestUsecase(t *testing.T) (*biz.ContentUsecase, *Data) {
+	t.Helper()
+	d := newTestData(t, nil)
+	logger := log.NewStdLogger(io.Discard)
+	c := &conf.Data{}
+	repo := NewContentRepo(c, d, logger)
+	search, cleanup, err := NewSearchRepo(c, logger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(cleanup)
+	idempotency, cleanup, err := NewIdempotencyRepo(c, d, logger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(cleanup)
+	events, err := NewEventBus(c, d, logger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	uc := biz.NewContentUsecase(repo, search, NewRevisionRepo(d, logger), idempotency, NewOutboxRepo(d, logger), events, &testIDs{}, logger)
+	return uc, d
+}
+
+// newTestContent 返回一条必填字段齐全的新内容
+func newTestContent(title string) *biz.Content {
+	return &biz.Content{
+		Title:    title,
+		VideoURL: "https://example.com/" + title + ".mp4",
+		Author:   "author",
+	}
+}
+
+// createUsecaseContent 通过 uc 创建内容, 返回其 id
+func createUsecaseContent(t *testing.T, uc *biz.ContentUsecase, title string) int64 {
+	t.Helper()
+	id, _, err := uc.CreateContent(context.Background(), newTestContent(title), "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return id
+}
+
+// countContents 返回索引表中的内容数, 包括回收站中的内容
+func countContents(t *testing.T, d *Data) int64 {
+	t.Helper()
+	var n int64
+	if err := d.db.Unscoped().Model(&IdxContentDetail{}).Count(&n).Error; err != nil {
+		t.Fatal(err)
+	}
+	return n
+}
+
+func TestExecTxRollsBackIndex(t *testing.T) {
+	d := newTestData(t, nil)
+	repo := &contentRepo{data: d, log: log.NewHelper(log.NewStdLogger(io.Discard))}
+	ctx := context.Background()
+	content := &biz.Content{ID: 1, ContentID: "content-1", Title: "title", VideoURL: "v", Author: "a"}
+	// 详情表写入失败
+	if err := d.db.Migrator().DropTable(d.router.Table(content.ContentID)); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := repo.Create(ctx, content); err == nil {
+		t.Fatal("Create() error = nil, want the detail write error")
+	}
+	var n int64
+	if err := d.db.Model(&IdxContentDetail{}).Count(&n).Error; err != nil {
+		t.Fatal(err)
+	}
+	if n != 0 {
+		t.Fatalf("index rows = %d after the detail write failed, want 0", n)
+	}
+}
+
+func TestExecTxAfterCommit(t *testing.T) {
+	d := newTestData(t, nil)
+	ctx := context.Background()
+	var ran []string
+
+	rollback := errors.New("rollback")
+	err := d.ExecTx(ctx, func(ctx context.Context) error {
+		d.afterCommit(ctx, func() { ran = append(ran, "rolled back") })
+		return rollback
+	})
+	if !errors.Is(err, rollback) {
+		t.Fatalf("ExecTx() error = %v, want %v", err, rollback)
+	}
+
+	err = d.ExecTx(ctx, func(ctx context.Context) error {
+		d.afterCommit(ctx, func() { ran = append(ran, "outer") })
+		// 嵌套的 ExecTx 加入外层事务, 回调在外层提交后执行
+		return d.ExecTx(ctx, func(ctx context.Context) error {
+			if !d.inTx(ctx) {
+				t.Error("nested ExecTx is not in the outer transaction")
+			}
+			d.afterCommit(ctx, func() { ran = append(ran, "inner") })
+			if len(ran) != 0 {
+				t.Errorf("hooks ran before commit: %v", ran)
+			}
+			return nil
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ran) != 2 || ran[0] != "outer" || ran[1] != "inner" {
+		t.Fatalf("hooks ran = %v, want [outer inner]", ran)
+	}
+
+	// 不在事务中时立即执行
+	d.afterCommit(ctx, func() { ran = append(ran, "now") })
+	if len(ran) != 3 {
+		t.Fatalf("hooks ran = %v, want the hook outside a transaction to run at once", ran)
+	}
+}
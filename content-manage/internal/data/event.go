@@ -0,0 +1,167 @@
+package data
+
+import (
+	"content_manage/api/event"
+	"content_manage/internal/biz"
+	"content_manage/internal/conf"
+	"fmt"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// 事件格式版本, 与 api/event/event.proto 一致
+	eventSchemaVersion = 1
+
+	defaultEventStream = "cms:content:events"
+	// 流中默认保留的事件数量
+	defaultEventMaxLen = 100000
+)
+
+// NewEventBus 根据 data.event.driver 创建事件总线, 默认使用 redis, 未配置 redis 时使用内存实现
+func NewEventBus(c *conf.Data, data *Data, logger log.Logger) (biz.EventBus, error) {
+	helper := log.NewHelper(logger)
+	driver := c.GetEvent().GetDriver()
+	if driver == "" {
+		driver = "redis"
+		if data.rdb == nil {
+			driver = "memory"
+		}
+	}
+	maxLen := c.GetEvent().GetMaxLen()
+	if maxLen <= 0 {
+		maxLen = defaultEventMaxLen
+	}
+	switch driver {
+	case "redis":
+		if data.rdb == nil {
+			return nil, fmt.Errorf("event driver redis requires data.redis")
+		}
+		stream := c.GetEvent().GetStream()
+		if stream == "" {
+			stream = defaultEventStream
+		}
+		helper.Infof("content events are published to redis stream %s", stream)
+		return newRedisEventBus(data.rdb, stream, maxLen, helper), nil
+	case "memory":
+		helper.Warn("content events are kept in memory and lost on restart")
+		return newMemoryEventBus(maxLen), nil
+	default:
+		return nil, fmt.Errorf("unknown event driver %q", driver)
+	}
+}
+
+// toEventProto 转换为 api/event 中的 protobuf 格式
+func toEventProto(e *biz.ContentEvent) *event.ContentEvent {
+	pb := &event.ContentEvent{
+		SchemaVersion: eventSchemaVersion,
+		EventId:       e.ID,
+		OccurredAt:    timestamppb.New(e.OccurredAt),
+		Id:            e.Content.ID,
+		ContentId:     e.Content.ContentID,
+		Version:       e.Content.Version,
+	}
+	switch e.Type {
+	case biz.EventContentCreated:
+		pb.Payload = &event.ContentEvent_Created{Created: &event.ContentCreated{Content: toEventContent(e.Content)}}
+	case biz.EventContentUpdated:
+		pb.Payload = &event.ContentEvent_Updated{Updated: &event.ContentUpdated{
+			Content:       toEventContent(e.Content),
+			ChangedFields: e.ChangedFields,
+			Editor:        e.Editor,
+		}}
+	case biz.EventContentDeleted:
+		pb.Payload = &event.ContentEvent_Deleted{Deleted: &event.ContentDeleted{Content: toEventContent(e.Content)}}
+	case biz.EventApprovalChanged:
+		pb.Payload = &event.ContentEvent_ApprovalChanged{ApprovalChanged: &event.ApprovalChanged{
+			From:    e.ApprovalFrom,
+			To:      e.Content.ApprovalStatus,
+			Content: toEventContent(e.Content),
+		}}
+	}
+	return pb
+}
+
+func toEventContent(c *biz.Content) *event.Content {
+	return &event.Content{
+		Id:             c.ID,
+		ContentId:      c.ContentID,
+		Title:          c.Title,
+		VideoUrl:       c.VideoURL,
+		Author:         c.Author,
+		Description:    c.Description,
+		Thumbnail:      c.Thumbnail,
+		Category:       c.Category,
+		Duration:       durationpb.New(c.Duration),
+		DurationNs:     int64(c.Duration),
+		Resolution:     c.Resolution,
+		FileSize:       c.FileSize,
+		Format:         c.Format,
+		Quality:        c.Quality,
+		ApprovalStatus: c.ApprovalStatus,
+		Version:        c.Version,
+		CreatedAt:      timestamppb.New(c.CreatedAt),
+		UpdatedAt:      timestamppb.New(c.UpdatedAt),
+	}
+}
+
+// fromEventProto 转换为 biz.ContentEvent, 不认识的事件类型返回错误
+func fromEventProto(pb *event.ContentEvent) (*biz.ContentEvent, error) {
+	e := &biz.ContentEvent{
+		ID:         pb.GetEventId(),
+		OccurredAt: pb.GetOccurredAt().AsTime(),
+	}
+	switch p := pb.GetPayload().(type) {
+	case *event.ContentEvent_Created:
+		e.Type = biz.EventContentCreated
+		e.Content = fromEventContent(p.Created.GetContent())
+	case *event.ContentEvent_Updated:
+		e.Type = biz.EventContentUpdated
+		e.Content = fromEventContent(p.Updated.GetContent())
+		e.ChangedFields = p.Updated.GetChangedFields()
+		e.Editor = p.Updated.GetEditor()
+	case *event.ContentEvent_Deleted:
+		e.Type = biz.EventContentDeleted
+		e.Content = fromEventContent(p.Deleted.GetContent())
+	case *event.ContentEvent_ApprovalChanged:
+		e.Type = biz.EventApprovalChanged
+		e.Content = fromEventContent(p.ApprovalChanged.GetContent())
+		e.ApprovalFrom = p.ApprovalChanged.GetFrom()
+	default:
+		return nil, fmt.Errorf("event %s has unknown payload, schema version = %d", pb.GetEventId(), pb.GetSchemaVersion())
+	}
+	return e, nil
+}
+
+func fromEventContent(c *event.Content) *biz.Content {
+	return &biz.Content{
+		ID:             c.GetId(),
+		ContentID:      c.GetContentId(),
+		Title:          c.GetTitle(),
+		VideoURL:       c.GetVideoUrl(),
+		Author:         c.GetAuthor(),
+		Description:    c.GetDescription(),
+		Thumbnail:      c.GetThumbnail(),
+		Category:       c.GetCategory(),
+		Duration:       eventDuration(c),
+		Resolution:     c.GetResolution(),
+		FileSize:       c.GetFileSize(),
+		Format:         c.GetFormat(),
+		Quality:        c.GetQuality(),
+		ApprovalStatus: c.GetApprovalStatus(),
+		Version:        c.GetVersion(),
+		CreatedAt:      c.GetCreatedAt().AsTime(),
+		UpdatedAt:      c.GetUpdatedAt().AsTime(),
+	}
+}
+
+// eventDuration 读取时长, 旧版发布的事件只有 duration_ns
+func eventDuration(c *event.Content) time.Duration {
+	if c.GetDuration() != nil {
+		return c.GetDuration().AsDuration()
+	}
+	return time.Duration(c.GetDurationNs())
+}
@@ -0,0 +1,186 @@
+package data
+
+import (
+	"content_manage/internal/biz"
+	"context"
+	"strconv"
+	"sync"
+)
+
+// memoryEventBus 进程内的事件总线, 用于本地开发和测试; 事件的 offset 为其序号, 从 1 开始.
+// 与 redis stream 一样只保留最近 maxLen 个事件, 更早的事件被丢弃, 序号不变
+type memoryEventBus struct {
+	mu     sync.Mutex
+	events []*biz.ContentEvent
+	// first events[0] 的序号减 1, 即已丢弃的事件数量
+	first  int
+	maxLen int
+	groups map[string]*memoryGroup
+	// notify 有新事件时关闭并替换
+	notify chan struct{}
+}
+
+type memoryGroup struct {
+	// next 下一个投递的事件下标, 不随丢弃变化
+	next int
+	// retry 处理失败等待重新投递的事件下标
+	retry []int
+}
+
+func newMemoryEventBus(maxLen int64) *memoryEventBus {
+	return &memoryEventBus{
+		maxLen: int(maxLen),
+		groups: make(map[string]*memoryGroup),
+		notify: make(chan struct{}),
+	}
+}
+
+func (b *memoryEventBus) Publish(ctx context.Context, events ...*biz.ContentEvent) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.events = append(b.events, events...)
+	b.trim()
+	close(b.notify)
+	b.notify = make(chan struct{})
+	return nil
+}
+
+// trim 超出 maxLen 一定数量后一次丢弃最早的事件, 与 XADD MAXLEN ~ 一样不在每次发布时复制
+func (b *memoryEventBus) trim() {
+	if len(b.events) <= b.maxLen+b.maxLen/8 {
+		return
+	}
+	drop := len(b.events) - b.maxLen
+	b.events = append([]*biz.ContentEvent(nil), b.events[drop:]...)
+	b.first += drop
+}
+
+// end 最后一个事件之后的下标
+func (b *memoryEventBus) end() int {
+	return b.first + len(b.events)
+}
+
+// position offset 之后第一个事件的下标, 早于保留范围时从最早保留的事件开始
+func (b *memoryEventBus) position(offset string) (int, error) {
+	switch offset {
+	case biz.OffsetOldest:
+		return b.first, nil
+	case biz.OffsetLatest, "":
+		return b.end(), nil
+	}
+	n, err := strconv.Atoi(offset)
+	if err != nil || n < 0 {
+		return 0, biz.ErrInvalidResumeToken
+	}
+	if n > b.end() {
+		n = b.end()
+	}
+	if n < b.first {
+		n = b.first
+	}
+	return n, nil
+}
+
+// event 返回下标 i 的事件副本, 带上 offset
+func (b *memoryEventBus) event(i int) *biz.ContentEvent {
+	e := *b.events[i-b.first]
+	e.Offset = strconv.Itoa(i + 1)
+	return &e
+}
+
+func (b *memoryEventBus) Subscribe(ctx context.Context, group, consumer, from string, handler biz.EventHandler) error {
+	b.mu.Lock()
+	if _, ok := b.groups[group]; !ok {
+		next, err := b.position(from)
+		if err != nil {
+			b.mu.Unlock()
+			return err
+		}
+		b.groups[group] = &memoryGroup{next: next}
+	}
+	b.mu.Unlock()
+
+	for ctx.Err() == nil {
+		i, e, wait := b.take(group)
+		if wait != nil {
+			select {
+			case <-ctx.Done():
+			case <-wait:
+			}
+			continue
+		}
+		if err := handler(ctx, e); err != nil {
+			b.mu.Lock()
+			g := b.groups[group]
+			g.retry = append(g.retry, i)
+			b.mu.Unlock()
+			sleep(ctx, eventRetryDelay)
+		}
+	}
+	return nil
+}
+
+// take 取出组内下一个待投递的事件及其下标, 没有时返回等待新事件的 channel; 已被丢弃的事件不再投递
+func (b *memoryEventBus) take(group string) (int, *biz.ContentEvent, <-chan struct{}) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	g := b.groups[group]
+	for len(g.retry) > 0 {
+		i := g.retry[0]
+		g.retry = g.retry[1:]
+		if i >= b.first {
+			return i, b.event(i), nil
+		}
+	}
+	if g.next < b.first {
+		g.next = b.first
+	}
+	if g.next >= b.end() {
+		return 0, nil, b.notify
+	}
+	i := g.next
+	g.next++
+	return i, b.event(i), nil
+}
+
+func (b *memoryEventBus) Seek(ctx context.Context, group, offset string) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	next, err := b.position(offset)
+	if err != nil {
+		return err
+	}
+	b.groups[group] = &memoryGroup{next: next}
+	return nil
+}
+
+func (b *memoryEventBus) Tail(ctx context.Context, from string, handler biz.EventHandler) error {
+	b.mu.Lock()
+	next, err := b.position(from)
+	b.mu.Unlock()
+	if err != nil {
+		return err
+	}
+	for ctx.Err() == nil {
+		b.mu.Lock()
+		if next < b.first {
+			next = b.first
+		}
+		if next >= b.end() {
+			wait := b.notify
+			b.mu.Unlock()
+			select {
+			case <-ctx.Done():
+			case <-wait:
+			}
+			continue
+		}
+		e := b.event(next)
+		b.mu.Unlock()
+		next++
+		if err := handler(ctx, e); err != nil {
+			return err
+		}
+	}
+	return nil
+}
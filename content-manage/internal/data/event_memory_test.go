@@ -0,0 +1,152 @@
+package data
+
+import (
+	"content_manage/internal/biz"
+	"context"
+	"errors"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func publishTestEvents(t *testing.T, bus biz.EventBus, n int) {
+	t.Helper()
+	for i := 0; i < n; i++ {
+		e := &biz.ContentEvent{ID: strconv.Itoa(i), Type: biz.EventContentUpdated, Content: &biz.Content{}}
+		if err := bus.Publish(context.Background(), e); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+// subscribeTest 在后台订阅 group, 返回收到的事件 offset
+func subscribeTest(t *testing.T, bus biz.EventBus, group, from string, handler biz.EventHandler) <-chan string {
+	t.Helper()
+	return subscribeConsumer(t, bus, group, "consumer", from, handler)
+}
+
+func subscribeConsumer(t *testing.T, bus biz.EventBus, group, consumer, from string, handler biz.EventHandler) <-chan string {
+	t.Helper()
+	ctx, cancel := context.WithCancel(context.Background())
+	offsets := make(chan string, 100)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		bus.Subscribe(ctx, group, consumer, from, func(ctx context.Context, e *biz.ContentEvent) error {
+			if handler != nil {
+				if err := handler(ctx, e); err != nil {
+					return err
+				}
+			}
+			offsets <- e.Offset
+			return nil
+		})
+	}()
+	t.Cleanup(func() {
+		cancel()
+		<-done
+	})
+	return offsets
+}
+
+func expectOffsets(t *testing.T, offsets <-chan string, want ...string) {
+	t.Helper()
+	for _, w := range want {
+		select {
+		case got := <-offsets:
+			if got != w {
+				t.Fatalf("offset = %s, want %s", got, w)
+			}
+		case <-time.After(3 * time.Second):
+			t.Fatalf("timed out waiting for offset %s", w)
+		}
+	}
+	select {
+	case got := <-offsets:
+		t.Fatalf("unexpected offset %s", got)
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+func TestMemoryEventBusSubscribe(t *testing.T) {
+	bus := newMemoryEventBus(100)
+	publishTestEvents(t, bus, 2)
+
+	// 新的组从 from 开始, 每个组都收到全部事件
+	oldest := subscribeTest(t, bus, "oldest", biz.OffsetOldest, nil)
+	expectOffsets(t, oldest, "1", "2")
+	latest := subscribeTest(t, bus, "latest", biz.OffsetLatest, nil)
+	after := subscribeTest(t, bus, "after", "1", nil)
+	expectOffsets(t, after, "2")
+
+	publishTestEvents(t, bus, 1)
+	expectOffsets(t, oldest, "3")
+	expectOffsets(t, latest, "3")
+	expectOffsets(t, after, "3")
+}
+
+func TestMemoryEventBusRetry(t *testing.T) {
+	bus := newMemoryEventBus(100)
+	publishTestEvents(t, bus, 1)
+	failed := false
+	offsets := subscribeTest(t, bus, "group", biz.OffsetOldest, func(ctx context.Context, e *biz.ContentEvent) error {
+		if !failed {
+			failed = true
+			return errors.New("handler failed")
+		}
+		return nil
+	})
+	// 处理失败的事件重新投递
+	expectOffsets(t, offsets, "1")
+}
+
+func TestMemoryEventBusSeek(t *testing.T) {
+	bus := newMemoryEventBus(100)
+	publishTestEvents(t, bus, 3)
+	ctx := context.Background()
+
+	if err := bus.Seek(ctx, "group", "1"); err != nil {
+		t.Fatal(err)
+	}
+	// 已存在的组从 Seek 的位置继续, 忽略 from
+	offsets := subscribeTest(t, bus, "group", biz.OffsetLatest, nil)
+	expectOffsets(t, offsets, "2", "3")
+
+	if err := bus.Seek(ctx, "group", "x"); !errors.Is(err, biz.ErrInvalidResumeToken) {
+		t.Fatalf("Seek() error = %v, want ErrInvalidResumeToken", err)
+	}
+}
+
+func TestMemoryEventBusMaxLen(t *testing.T) {
+	bus := newMemoryEventBus(8)
+	publishTestEvents(t, bus, 100)
+	if n := len(bus.events); n > 9 {
+		t.Fatalf("retained %d events, want at most 9", n)
+	}
+	first := bus.first
+
+	// 早于保留范围的 offset 从最早保留的事件开始, offset 不随丢弃变化
+	offsets := subscribeTest(t, bus, "group", "1", nil)
+	want := make([]string, 0, 100-first)
+	for i := first + 1; i <= 100; i++ {
+		want = append(want, strconv.Itoa(i))
+	}
+	expectOffsets(t, offsets, want...)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	var tailed []string
+	err := bus.Tail(ctx, biz.OffsetOldest, func(ctx context.Context, e *biz.ContentEvent) error {
+		tailed = append(tailed, e.Offset)
+		if e.Offset == "100" {
+			cancel()
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(tailed) != len(want) || tailed[0] != want[0] {
+		t.Fatalf("Tail() offsets = %v, want %v", tailed, want)
+	}
+}
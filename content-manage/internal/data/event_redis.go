@@ -0,0 +1,196 @@
+package data
+
+import (
+	"content_manage/api/event"
+	"content_manage/internal/biz"
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/redis/go-redis/v9"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// 每次读取的事件数量和无新事件时的阻塞时间
+	eventReadCount = 100
+	eventReadBlock = 5 * time.Second
+	// 处理失败或 redis 出错后的重试间隔
+	eventRetryDelay = time.Second
+)
+
+// redisEventBus 基于 redis stream 的事件总线, 事件 id 即 offset
+type redisEventBus struct {
+	rdb    *redis.Client
+	stream string
+	maxLen int64
+	log    *log.Helper
+}
+
+func newRedisEventBus(rdb *redis.Client, stream string, maxLen int64, log *log.Helper) *redisEventBus {
+	return &redisEventBus{rdb: rdb, stream: stream, maxLen: maxLen, log: log}
+}
+
+func (b *redisEventBus) Publish(ctx context.Context, events ...*biz.ContentEvent) error {
+	pipe := b.rdb.Pipeline()
+	for _, e := range events {
+		payload, err := proto.Marshal(toEventProto(e))
+		if err != nil {
+			return err
+		}
+		pipe.XAdd(ctx, &redis.XAddArgs{
+			Stream: b.stream,
+			MaxLen: b.maxLen,
+			Approx: true,
+			Values: map[string]interface{}{
+				"type":    string(e.Type),
+				"payload": payload,
+			},
+		})
+	}
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+// createGroup 创建消费组, 已存在时不修改其位置
+func (b *redisEventBus) createGroup(ctx context.Context, group, from string) error {
+	err := b.rdb.XGroupCreateMkStream(ctx, b.stream, group, from).Err()
+	if err != nil && strings.HasPrefix(err.Error(), "BUSYGROUP") {
+		return nil
+	}
+	return err
+}
+
+func (b *redisEventBus) Subscribe(ctx context.Context, group, consumer, from string, handler biz.EventHandler) error {
+	if from == "" {
+		from = biz.OffsetLatest
+	}
+	if err := b.createGroup(ctx, group, from); err != nil {
+		return err
+	}
+	// 先处理本消费者已读取但未确认的事件, 再读取新事件
+	pending := true
+	for ctx.Err() == nil {
+		id := ">"
+		if pending {
+			id = "0"
+		}
+		streams, err := b.rdb.XReadGroup(ctx, &redis.XReadGroupArgs{
+			Group:    group,
+			Consumer: consumer,
+			Streams:  []string{b.stream, id},
+			Count:    eventReadCount,
+			Block:    eventReadBlock,
+		}).Result()
+		if errors.Is(err, redis.Nil) {
+			continue
+		}
+		if err != nil {
+			if ctx.Err() == nil {
+				b.log.Errorf("event stream %s group %s read error = %v", b.stream, group, err)
+				sleep(ctx, eventRetryDelay)
+			}
+			continue
+		}
+		msgs := streams[0].Messages
+		if pending && len(msgs) == 0 {
+			pending = false
+			continue
+		}
+		for _, msg := range msgs {
+			if err := b.handle(ctx, msg, handler); err != nil {
+				// 保持顺序, 从失败的事件开始重新处理
+				b.log.Errorf("event stream %s group %s handle %s error = %v", b.stream, group, msg.ID, err)
+				pending = true
+				sleep(ctx, eventRetryDelay)
+				break
+			}
+			if err := b.rdb.XAck(ctx, b.stream, group, msg.ID).Err(); err != nil {
+				b.log.Errorf("event stream %s group %s ack %s error = %v", b.stream, group, msg.ID, err)
+			}
+		}
+	}
+	return nil
+}
+
+// handle 解码失败的事件无法处理, 记录日志后跳过
+func (b *redisEventBus) handle(ctx context.Context, msg redis.XMessage, handler biz.EventHandler) error {
+	payload, _ := msg.Values["payload"].(string)
+	var pb event.ContentEvent
+	if err := proto.Unmarshal([]byte(payload), &pb); err != nil {
+		b.log.Errorf("event stream %s skip %s, decode error = %v", b.stream, msg.ID, err)
+		return nil
+	}
+	e, err := fromEventProto(&pb)
+	if err != nil {
+		b.log.Errorf("event stream %s skip %s, error = %v", b.stream, msg.ID, err)
+		return nil
+	}
+	e.Offset = msg.ID
+	return handler(ctx, e)
+}
+
+func (b *redisEventBus) Seek(ctx context.Context, group, offset string) error {
+	err := b.rdb.XGroupSetID(ctx, b.stream, group, offset).Err()
+	if err != nil && strings.Contains(err.Error(), "NOGROUP") {
+		return b.createGroup(ctx, group, offset)
+	}
+	if err != nil {
+		return fmt.Errorf("seek group %s to %s: %w", group, offset, err)
+	}
+	return nil
+}
+
+func (b *redisEventBus) Tail(ctx context.Context, from string, handler biz.EventHandler) error {
+	// $ 只在第一次读取时有效, 先换成当前最后一个事件的 id, 避免两次读取之间的事件丢失
+	if from == biz.OffsetLatest || from == "" {
+		last, err := b.rdb.XRevRangeN(ctx, b.stream, "+", "-", 1).Result()
+		if err != nil {
+			return err
+		}
+		from = biz.OffsetOldest
+		if len(last) > 0 {
+			from = last[0].ID
+		}
+	}
+	for ctx.Err() == nil {
+		streams, err := b.rdb.XRead(ctx, &redis.XReadArgs{
+			Streams: []string{b.stream, from},
+			Count:   eventReadCount,
+			Block:   eventReadBlock,
+		}).Result()
+		if errors.Is(err, redis.Nil) {
+			continue
+		}
+		if err != nil && strings.Contains(err.Error(), "Invalid stream ID") {
+			return biz.ErrInvalidResumeToken
+		}
+		if err != nil {
+			if ctx.Err() == nil {
+				b.log.Errorf("event stream %s tail error = %v", b.stream, err)
+				sleep(ctx, eventRetryDelay)
+			}
+			continue
+		}
+		for _, msg := range streams[0].Messages {
+			if err := b.handle(ctx, msg, handler); err != nil {
+				return err
+			}
+			from = msg.ID
+		}
+	}
+	return nil
+}
+
+// sleep 等待 d 或 ctx 结束
+func sleep(ctx context.Context, d time.Duration) {
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-ctx.Done():
+	case <-t.C:
+	}
+}
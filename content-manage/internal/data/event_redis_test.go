@@ -0,0 +1,260 @@
+package data
+
+import (
+	"content_manage/internal/biz"
+	"context"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/redis/go-redis/v9"
+)
+
+const testEventStream = "cms:content:events"
+
+func newTestRedisBus(t *testing.T, maxLen int64) (*redisEventBus, *redis.Client) {
+	t.Helper()
+	mr := miniredis.RunT(t)
+	rdb := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	rdb.AddHook(setIDHook{rdb: rdb})
+	t.Cleanup(func() { rdb.Close() })
+	return newRedisEventBus(rdb, testEventStream, maxLen, log.NewHelper(log.NewStdLogger(io.Discard))), rdb
+}
+
+// setIDHook miniredis 不支持 XGROUP SETID, 测试中用 DESTROY + CREATE 模拟, 组不存在时与 redis 一样返回 NOGROUP
+type setIDHook struct {
+	rdb *redis.Client
+}
+
+func (setIDHook) DialHook(next redis.DialHook) redis.DialHook {
+	return next
+}
+
+func (h setIDHook) ProcessHook(next redis.ProcessHook) redis.ProcessHook {
+	return func(ctx context.Context, cmd redis.Cmder) error {
+		args := cmd.Args()
+		if len(args) != 5 || cmd.Name() != "xgroup" || args[1] != "setid" {
+			return next(ctx, cmd)
+		}
+		stream, group, id := args[2].(string), args[3].(string), args[4].(string)
+		n, err := h.rdb.XGroupDestroy(ctx, stream, group).Result()
+		if err == nil && n == 0 {
+			err = errors.New("NOGROUP No such key or consumer group")
+		}
+		if err == nil {
+			err = h.rdb.XGroupCreate(ctx, stream, group, id).Err()
+		}
+		cmd.SetErr(err)
+		return err
+	}
+}
+
+func (setIDHook) ProcessPipelineHook(next redis.ProcessPipelineHook) redis.ProcessPipelineHook {
+	return next
+}
+
+// subscribeRedis 在 subscribeTest 之后关闭连接, 结束阻塞中的 XREADGROUP, 测试结束时不等待 eventReadBlock
+func subscribeRedis(t *testing.T, bus *redisEventBus, group, consumer, from string, handler biz.EventHandler) <-chan string {
+	t.Helper()
+	offsets := subscribeConsumer(t, bus, group, consumer, from, handler)
+	t.Cleanup(func() { bus.rdb.Close() })
+	return offsets
+}
+
+// streamIDs 返回流中事件的 id, 即 offset
+func streamIDs(t *testing.T, rdb *redis.Client) []string {
+	t.Helper()
+	msgs, err := rdb.XRange(context.Background(), testEventStream, "-", "+").Result()
+	if err != nil {
+		t.Fatal(err)
+	}
+	ids := make([]string, 0, len(msgs))
+	for _, msg := range msgs {
+		ids = append(ids, msg.ID)
+	}
+	return ids
+}
+
+func TestRedisEventBusSubscribe(t *testing.T) {
+	bus, rdb := newTestRedisBus(t, 100)
+	publishTestEvents(t, bus, 2)
+	ids := streamIDs(t, rdb)
+
+	// 新的组从 from 开始, 每个组都收到全部事件
+	oldest := subscribeRedis(t, bus, "oldest", "consumer", biz.OffsetOldest, nil)
+	expectOffsets(t, oldest, ids...)
+	latest := subscribeRedis(t, bus, "latest", "consumer", biz.OffsetLatest, nil)
+	after := subscribeRedis(t, bus, "after", "consumer", ids[0], nil)
+	expectOffsets(t, after, ids[1])
+
+	publishTestEvents(t, bus, 1)
+	ids = streamIDs(t, rdb)
+	expectOffsets(t, oldest, ids[2])
+	expectOffsets(t, latest, ids[2])
+	expectOffsets(t, after, ids[2])
+
+	// 已确认的事件不再属于任何消费者
+	pending, err := rdb.XPending(context.Background(), testEventStream, "oldest").Result()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pending.Count != 0 {
+		t.Fatalf("group oldest has %d pending events, want 0", pending.Count)
+	}
+}
+
+func TestRedisEventBusConsumers(t *testing.T) {
+	bus, rdb := newTestRedisBus(t, 100)
+	a := subscribeRedis(t, bus, "group", "a", biz.OffsetOldest, nil)
+	b := subscribeRedis(t, bus, "group", "b", biz.OffsetOldest, nil)
+	// 等两个消费者都开始读取
+	time.Sleep(100 * time.Millisecond)
+	publishTestEvents(t, bus, 4)
+
+	// 同组的消费者分摊事件, 每个事件只投递一次
+	seen := make(map[string]int)
+	for i := 0; i < 4; i++ {
+		select {
+		case offset := <-a:
+			seen[offset]++
+		case offset := <-b:
+			seen[offset]++
+		case <-time.After(3 * time.Second):
+			t.Fatalf("received %d events, want 4", i)
+		}
+	}
+	for _, id := range streamIDs(t, rdb) {
+		if seen[id] != 1 {
+			t.Fatalf("event %s delivered %d times, want once", id, seen[id])
+		}
+	}
+	select {
+	case offset := <-a:
+		t.Fatalf("unexpected offset %s", offset)
+	case offset := <-b:
+		t.Fatalf("unexpected offset %s", offset)
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+func TestRedisEventBusRetry(t *testing.T) {
+	bus, rdb := newTestRedisBus(t, 100)
+	publishTestEvents(t, bus, 1)
+	failed := false
+	offsets := subscribeRedis(t, bus, "group", "consumer", biz.OffsetOldest, func(ctx context.Context, e *biz.ContentEvent) error {
+		if !failed {
+			failed = true
+			return errors.New("handler failed")
+		}
+		return nil
+	})
+	// 处理失败的事件未确认, 从本消费者的待确认事件中重新投递
+	expectOffsets(t, offsets, streamIDs(t, rdb)...)
+}
+
+func TestRedisEventBusSeek(t *testing.T) {
+	bus, rdb := newTestRedisBus(t, 100)
+	publishTestEvents(t, bus, 3)
+	ids := streamIDs(t, rdb)
+	ctx := context.Background()
+
+	// 不存在的组在 Seek 时创建
+	if err := bus.Seek(ctx, "group", ids[0]); err != nil {
+		t.Fatal(err)
+	}
+	// 已存在的组从 Seek 的位置继续, 忽略 from
+	offsets := subscribeRedis(t, bus, "group", "consumer", biz.OffsetLatest, nil)
+	expectOffsets(t, offsets, ids[1], ids[2])
+
+	// 移回最早的位置后重新消费
+	if err := bus.Seek(ctx, "group", biz.OffsetOldest); err != nil {
+		t.Fatal(err)
+	}
+	replay := subscribeRedis(t, bus, "group", "replay", biz.OffsetLatest, nil)
+	expectOffsets(t, replay, ids...)
+}
+
+// tailTest 在后台 Tail, 返回收到的事件 offset 和 Tail 的返回值
+func tailTest(t *testing.T, bus *redisEventBus, from string) (<-chan string, <-chan error) {
+	t.Helper()
+	ctx, cancel := context.WithCancel(context.Background())
+	offsets := make(chan string, 100)
+	errc := make(chan error, 1)
+	go func() {
+		errc <- bus.Tail(ctx, from, func(ctx context.Context, e *biz.ContentEvent) error {
+			offsets <- e.Offset
+			return nil
+		})
+	}()
+	t.Cleanup(func() {
+		bus.rdb.Close()
+		cancel()
+	})
+	return offsets, errc
+}
+
+func TestRedisEventBusTail(t *testing.T) {
+	bus, rdb := newTestRedisBus(t, 100)
+	publishTestEvents(t, bus, 2)
+	ids := streamIDs(t, rdb)
+
+	// 从指定的事件之后开始
+	after, _ := tailTest(t, bus, ids[0])
+	expectOffsets(t, after, ids[1])
+
+	// 只收到订阅之后发布的事件, 不创建消费组
+	latest, _ := tailTest(t, bus, biz.OffsetLatest)
+	time.Sleep(100 * time.Millisecond)
+	publishTestEvents(t, bus, 1)
+	ids = streamIDs(t, rdb)
+	expectOffsets(t, latest, ids[2])
+	expectOffsets(t, after, ids[2])
+	groups, err := rdb.XInfoGroups(context.Background(), testEventStream).Result()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(groups) != 0 {
+		t.Fatalf("Tail() created %d consumer groups, want 0", len(groups))
+	}
+}
+
+func TestRedisEventBusTailInvalid(t *testing.T) {
+	bus, _ := newTestRedisBus(t, 100)
+	_, errc := tailTest(t, bus, "x")
+	select {
+	case err := <-errc:
+		if !errors.Is(err, biz.ErrInvalidResumeToken) {
+			t.Fatalf("Tail() error = %v, want ErrInvalidResumeToken", err)
+		}
+	case <-time.After(3 * time.Second):
+		t.Fatal("Tail() with an invalid offset did not return")
+	}
+}
+
+func TestRedisEventBusMaxLen(t *testing.T) {
+	bus, rdb := newTestRedisBus(t, 8)
+	publishTestEvents(t, bus, 20)
+	// XADD MAXLEN ~ 可能多保留一些, 但不会无限增长; miniredis 精确裁剪
+	n, err := rdb.XLen(context.Background(), testEventStream).Result()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != 8 {
+		t.Fatalf("stream length = %d, want 8", n)
+	}
+
+	// 保留的是最新的事件, 从最早保留的事件开始消费
+	ids := streamIDs(t, rdb)
+	events := make(chan string, 100)
+	offsets := subscribeRedis(t, bus, "group", "consumer", biz.OffsetOldest, func(ctx context.Context, e *biz.ContentEvent) error {
+		events <- e.ID
+		return nil
+	})
+	expectOffsets(t, offsets, ids...)
+	if first := <-events; first != "12" {
+		t.Fatalf("oldest retained event = %s, want 12", first)
+	}
+}
@@ -0,0 +1,57 @@
+package data
+
+import (
+	"content_manage/api/event"
+	"content_manage/internal/biz"
+	"reflect"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+)
+
+func TestEventProtoRoundTrip(t *testing.T) {
+	now := time.Unix(1700000000, 0).UTC()
+	e := &biz.ContentEvent{
+		ID:            "1-0",
+		Type:          biz.EventContentUpdated,
+		OccurredAt:    now,
+		ChangedFields: []string{"duration"},
+		Editor:        "editor",
+		Content: &biz.Content{
+			ID:        1,
+			ContentID: "content-1",
+			Title:     "title",
+			Duration:  90*time.Minute + time.Nanosecond,
+			Version:   2,
+			CreatedAt: now,
+			UpdatedAt: now,
+		},
+	}
+	data, err := proto.Marshal(toEventProto(e))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var pb event.ContentEvent
+	if err := proto.Unmarshal(data, &pb); err != nil {
+		t.Fatal(err)
+	}
+	if got := pb.GetUpdated().GetContent().GetDurationNs(); got != int64(e.Content.Duration) {
+		t.Fatalf("duration_ns = %d, want %d for consumers of the old schema", got, int64(e.Content.Duration))
+	}
+	got, err := fromEventProto(&pb)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got, e) {
+		t.Fatalf("fromEventProto() = %+v, want %+v", got, e)
+	}
+}
+
+func TestEventDurationFallback(t *testing.T) {
+	// 旧版发布的事件只有 duration_ns
+	c := &event.Content{DurationNs: int64(3 * time.Second)}
+	if got := eventDuration(c); got != 3*time.Second {
+		t.Fatalf("eventDuration() = %s, want %s", got, 3*time.Second)
+	}
+}
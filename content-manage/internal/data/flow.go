@@ -0,0 +1,63 @@
+package data
+
+import (
+	"bytes"
+	"content_manage/internal/biz"
+	"content_manage/internal/conf"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+const (
+	// content-flow 工作流默认地址
+	defaultFlowURL     = "http://localhost:7788/flow/content-flow"
+	defaultFlowTimeout = 5 * time.Second
+)
+
+type flowTrigger struct {
+	url    string
+	client *http.Client
+}
+
+// NewFlowTrigger 通过 http 启动 content-flow 工作流
+func NewFlowTrigger(c *conf.Data) biz.FlowTrigger {
+	url := c.GetFlow().GetUrl()
+	if url == "" {
+		url = defaultFlowURL
+	}
+	timeout := defaultFlowTimeout
+	if d := c.GetFlow().GetTimeout(); d != nil && d.AsDuration() > 0 {
+		timeout = d.AsDuration()
+	}
+	return &flowTrigger{
+		url:    url,
+		client: &http.Client{Timeout: timeout},
+	}
+}
+
+func (f *flowTrigger) Trigger(ctx context.Context, contentID string) error {
+	data, err := json.Marshal(&biz.FlowPayload{ContentID: contentID})
+	if err != nil {
+		return err
+	}
+	// content-flow 以 GET 请求体接收参数
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, f.url, bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	req.Header.Add("Content-Type", "application/json")
+	rsp, err := f.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer rsp.Body.Close()
+	if rsp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(rsp.Body, maxOutboxError))
+		return fmt.Errorf("content-flow status = %d, body = %s", rsp.StatusCode, body)
+	}
+	return nil
+}
@@ -0,0 +1,126 @@
+package data
+
+import (
+	"content_manage/internal/biz"
+	"content_manage/internal/conf"
+	"context"
+	"errors"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-sql-driver/mysql"
+	"gorm.io/gorm"
+)
+
+const (
+	// 幂等记录默认保留时间
+	defaultIdempotencyTTL = 24 * time.Hour
+	// 过期幂等记录的清理间隔和每批删除数量
+	idempotencySweepInterval = 10 * time.Minute
+	idempotencySweepBatch    = 1000
+	// mysql 唯一键冲突错误码
+	mysqlDuplicateEntry = 1062
+)
+
+// IdempotencyKey 创建请求的幂等记录, 过期后键可以被重新使用
+type IdempotencyKey struct {
+	Key         string    `gorm:"column:idempotency_key;primaryKey"` // 客户端传入的幂等键
+	Fingerprint string    `gorm:"column:fingerprint"`                // 请求内容的 sha256
+	ContentID   int64     `gorm:"column:content_id"`                 // 创建的索引表 id
+	ExpiresAt   time.Time `gorm:"column:expires_at"`                 // 过期时间
+	CreatedAt   time.Time `gorm:"column:created_at"`
+}
+
+func (*IdempotencyKey) TableName() string {
+	return "cms_content.t_idempotency_keys"
+}
+
+type idempotencyRepo struct {
+	data *Data
+	ttl  time.Duration
+	log  *log.Helper
+}
+
+// NewIdempotencyRepo 创建幂等记录仓库, 并定期删除过期记录
+func NewIdempotencyRepo(c *conf.Data, data *Data, logger log.Logger) (biz.IdempotencyRepo, func(), error) {
+	ttl := defaultIdempotencyTTL
+	if d := c.GetIdempotency().GetTtl(); d != nil && d.AsDuration() > 0 {
+		ttl = d.AsDuration()
+	}
+	r := &idempotencyRepo{
+		data: data,
+		ttl:  ttl,
+		log:  log.NewHelper(logger),
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		r.sweep(ctx)
+	}()
+	cleanup := func() {
+		cancel()
+		<-done
+	}
+	return r, cleanup, nil
+}
+
+func (r *idempotencyRepo) Get(ctx context.Context, key string) (*biz.IdempotencyRecord, error) {
+	var row IdempotencyKey
+	err := r.data.DB(ctx).Where("idempotency_key = ? AND expires_at > ?", key, time.Now()).First(&row).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &biz.IdempotencyRecord{Key: row.Key, Fingerprint: row.Fingerprint, ContentID: row.ContentID}, nil
+}
+
+func (r *idempotencyRepo) Save(ctx context.Context, rec *biz.IdempotencyRecord) error {
+	db := r.data.DB(ctx)
+	now := time.Now()
+	// 已过期但尚未清理的同名记录先删除
+	if err := db.Where("idempotency_key = ? AND expires_at <= ?", rec.Key, now).Delete(&IdempotencyKey{}).Error; err != nil {
+		return err
+	}
+	err := db.Create(&IdempotencyKey{
+		Key:         rec.Key,
+		Fingerprint: rec.Fingerprint,
+		ContentID:   rec.ContentID,
+		ExpiresAt:   now.Add(r.ttl),
+	}).Error
+	var mysqlErr *mysql.MySQLError
+	if errors.As(err, &mysqlErr) && mysqlErr.Number == mysqlDuplicateEntry {
+		return biz.ErrIdempotencyKeyExists
+	}
+	if err != nil {
+		r.log.WithContext(ctx).Errorf("idempotencyRepo Save error = %v\n", err)
+		return err
+	}
+	return nil
+}
+
+// sweep 定期分批删除过期记录
+func (r *idempotencyRepo) sweep(ctx context.Context) {
+	ticker := time.NewTicker(idempotencySweepInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		for {
+			result := r.data.DB(ctx).Where("expires_at <= ?", time.Now()).
+				Limit(idempotencySweepBatch).Delete(&IdempotencyKey{})
+			if result.Error != nil {
+				r.log.Errorf("idempotencyRepo sweep error = %v", result.Error)
+				break
+			}
+			if result.RowsAffected < idempotencySweepBatch {
+				break
+			}
+		}
+	}
+}
@@ -0,0 +1,71 @@
+package data
+
+import (
+	"content_manage/api/operate"
+	"content_manage/internal/biz"
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestCreateContentIdempotent(t *testing.T) {
+	uc, d := newTestUsecase(t)
+	ctx := context.Background()
+
+	id, created, err := uc.CreateContent(ctx, newTestContent("alpha"), "key-1")
+	if err != nil || !created {
+		t.Fatalf("CreateContent() = %d, %t, %v, want a new content", id, created, err)
+	}
+	// 相同的键和请求重放第一次的结果, 不再创建
+	replayed, created, err := uc.CreateContent(ctx, newTestContent("alpha"), "key-1")
+	if err != nil || created || replayed != id {
+		t.Fatalf("CreateContent() retry = %d, %t, %v, want %d not created", replayed, created, err, id)
+	}
+	if n := countContents(t, d); n != 1 {
+		t.Fatalf("contents = %d after a retry, want 1", n)
+	}
+
+	// 相同的键用于不同的请求时冲突
+	_, _, err = uc.CreateContent(ctx, newTestContent("bravo"), "key-1")
+	if !errors.Is(err, biz.ErrIdempotencyKeyReused) || !operate.IsContentConflict(err) {
+		t.Fatalf("CreateContent() with another request error = %v, want CONTENT_CONFLICT", err)
+	}
+	if n := countContents(t, d); n != 1 {
+		t.Fatalf("contents = %d after a conflict, want 1", n)
+	}
+
+	// 不同的键各自创建
+	other, created, err := uc.CreateContent(ctx, newTestContent("alpha"), "key-2")
+	if err != nil || !created || other == id {
+		t.Fatalf("CreateContent() with another key = %d, %t, %v, want a new content", other, created, err)
+	}
+
+	if _, _, err := uc.CreateContent(ctx, newTestContent("alpha"), strings.Repeat("k", 129)); !errors.Is(err, biz.ErrInvalidIdempotencyKey) {
+		t.Fatalf("CreateContent() with a long key error = %v, want ErrInvalidIdempotencyKey", err)
+	}
+}
+
+func TestCreateContentIdempotencyExpired(t *testing.T) {
+	uc, d := newTestUsecase(t)
+	ctx := context.Background()
+	id, _, err := uc.CreateContent(ctx, newTestContent("alpha"), "key-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := d.db.Model(&IdempotencyKey{}).Where("idempotency_key = ?", "key-1").
+		Update("expires_at", time.Now().Add(-time.Second)).Error; err != nil {
+		t.Fatal(err)
+	}
+
+	// 过期的键可以用于新的请求
+	other, created, err := uc.CreateContent(ctx, newTestContent("bravo"), "key-1")
+	if err != nil || !created || other == id {
+		t.Fatalf("CreateContent() with an expired key = %d, %t, %v, want a new content", other, created, err)
+	}
+	replayed, created, err := uc.CreateContent(ctx, newTestContent("bravo"), "key-1")
+	if err != nil || created || replayed != other {
+		t.Fatalf("CreateContent() retry = %d, %t, %v, want %d not created", replayed, created, err, other)
+	}
+}
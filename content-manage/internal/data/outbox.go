@@ -0,0 +1,134 @@
+package data
+
+import (
+	"content_manage/internal/biz"
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// 失败原因的最大保存长度, 与 last_error 列一致
+const maxOutboxError = 512
+
+// OutboxMessage 与内容变更在同一事务中写入的待投递消息, 投递成功后删除
+type OutboxMessage struct {
+	ID            int64     `gorm:"column:id;primaryKey"`
+	Topic         string    `gorm:"column:topic"`           // 消息类型, 如 content.flow
+	ContentID     string    `gorm:"column:content_id"`      // 同一类型同一内容的消息按 id 顺序投递, 为空时不排序
+	Payload       string    `gorm:"column:payload"`         // 消息内容, json
+	Attempts      int32     `gorm:"column:attempts"`        // 已失败的投递次数
+	LastError     string    `gorm:"column:last_error"`      // 最近一次失败原因
+	NextAttemptAt time.Time `gorm:"column:next_attempt_at"` // 下次投递时间
+	CreatedAt     time.Time `gorm:"column:created_at"`
+}
+
+func (*OutboxMessage) TableName() string {
+	return "cms_content.t_outbox"
+}
+
+type outboxRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewOutboxRepo .
+func NewOutboxRepo(data *Data, logger log.Logger) biz.OutboxRepo {
+	return &outboxRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func (r *outboxRepo) Add(ctx context.Context, msgs ...*biz.OutboxMessage) error {
+	if len(msgs) == 0 {
+		return nil
+	}
+	now := time.Now()
+	rows := make([]*OutboxMessage, 0, len(msgs))
+	for _, msg := range msgs {
+		rows = append(rows, &OutboxMessage{
+			Topic:         msg.Topic,
+			ContentID:     msg.ContentID,
+			Payload:       string(msg.Payload),
+			NextAttemptAt: now,
+		})
+	}
+	if err := r.data.DB(ctx).Create(&rows).Error; err != nil {
+		r.log.WithContext(ctx).Errorf("outboxRepo Add error = %v\n", err)
+		return err
+	}
+	for i, row := range rows {
+		msgs[i].ID = row.ID
+	}
+	return nil
+}
+
+// Claim 锁定到期的消息并推迟其下次投递时间, 多个实例同时投递时跳过已被锁定的行;
+// 同一内容之前的消息未投递(等待重试或已被其他实例认领)时跳过, 保证同一内容的消息按顺序投递
+func (r *outboxRepo) Claim(ctx context.Context, limit int, lease time.Duration) ([]*biz.OutboxMessage, error) {
+	var rows []*OutboxMessage
+	err := r.data.ExecTx(ctx, func(ctx context.Context) error {
+		db := r.data.DB(ctx)
+		now := time.Now()
+		table := (&OutboxMessage{}).TableName()
+		earlier := db.Session(&gorm.Session{NewDB: true}).Table(table + " AS e").Select("1").
+			Where("e.topic = " + table + ".topic AND e.content_id = " + table + ".content_id AND e.id < " + table + ".id")
+		if err := db.Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+			Where("next_attempt_at <= ?", now).
+			Where("content_id = '' OR NOT EXISTS (?)", earlier).
+			Order("id").
+			Limit(limit).
+			Find(&rows).Error; err != nil {
+			return err
+		}
+		if len(rows) == 0 {
+			return nil
+		}
+		ids := make([]int64, 0, len(rows))
+		for _, row := range rows {
+			ids = append(ids, row.ID)
+		}
+		return db.Model(&OutboxMessage{}).Where("id IN ?", ids).
+			Update("next_attempt_at", now.Add(lease)).Error
+	})
+	if err != nil {
+		r.log.WithContext(ctx).Errorf("outboxRepo Claim error = %v\n", err)
+		return nil, err
+	}
+	msgs := make([]*biz.OutboxMessage, 0, len(rows))
+	for _, row := range rows {
+		msgs = append(msgs, &biz.OutboxMessage{
+			ID:        row.ID,
+			Topic:     row.Topic,
+			ContentID: row.ContentID,
+			Payload:   []byte(row.Payload),
+			Attempts:  row.Attempts,
+		})
+	}
+	return msgs, nil
+}
+
+func (r *outboxRepo) Delivered(ctx context.Context, id int64) error {
+	return r.data.DB(ctx).Where("id = ?", id).Delete(&OutboxMessage{}).Error
+}
+
+func (r *outboxRepo) Failed(ctx context.Context, id int64, cause error, next time.Time) error {
+	msg := cause.Error()
+	if len(msg) > maxOutboxError {
+		msg = msg[:maxOutboxError]
+	}
+	return r.data.DB(ctx).Model(&OutboxMessage{}).Where("id = ?", id).Updates(map[string]interface{}{
+		"attempts":        gorm.Expr("attempts + 1"),
+		"last_error":      msg,
+		"next_attempt_at": next,
+	}).Error
+}
+
+func (r *outboxRepo) Backlog(ctx context.Context) (int64, error) {
+	var count int64
+	err := r.data.DB(ctx).Model(&OutboxMessage{}).Count(&count).Error
+	return count, err
+}
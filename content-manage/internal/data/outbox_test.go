@@ -0,0 +1,181 @@
+package data
+
+import (
+	"content_manage/internal/biz"
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+func newTestOutbox(t *testing.T) *outboxRepo {
+	t.Helper()
+	d := newTestData(t, nil)
+	return &outboxRepo{data: d, log: log.NewHelper(log.NewStdLogger(io.Discard))}
+}
+
+func addTestMessages(t *testing.T, repo *outboxRepo, n int) []*biz.OutboxMessage {
+	t.Helper()
+	msgs := make([]*biz.OutboxMessage, n)
+	for i := range msgs {
+		msgs[i] = &biz.OutboxMessage{Topic: biz.TopicContentFlow, Payload: []byte(`{"content_id":"c"}`)}
+	}
+	if err := repo.Add(context.Background(), msgs...); err != nil {
+		t.Fatal(err)
+	}
+	return msgs
+}
+
+func claimedIDs(t *testing.T, repo *outboxRepo, limit int, lease time.Duration) []int64 {
+	t.Helper()
+	msgs, err := repo.Claim(context.Background(), limit, lease)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ids := make([]int64, 0, len(msgs))
+	for _, msg := range msgs {
+		ids = append(ids, msg.ID)
+	}
+	return ids
+}
+
+func equalIDs(a, b []int64) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func TestOutboxClaimLease(t *testing.T) {
+	repo := newTestOutbox(t)
+	msgs := addTestMessages(t, repo, 3)
+	id := func(i int) int64 { return msgs[i].ID }
+
+	// 按 id 顺序认领, 认领后在租约期内对其他实例不可见
+	if ids := claimedIDs(t, repo, 2, time.Minute); !equalIDs(ids, []int64{id(0), id(1)}) {
+		t.Fatalf("Claim() = %v, want %v", ids, []int64{id(0), id(1)})
+	}
+	if ids := claimedIDs(t, repo, 2, time.Minute); !equalIDs(ids, []int64{id(2)}) {
+		t.Fatalf("Claim() = %v, want the unclaimed %d", ids, id(2))
+	}
+	if ids := claimedIDs(t, repo, 2, time.Minute); len(ids) != 0 {
+		t.Fatalf("Claim() = %v, want none while leased", ids)
+	}
+}
+
+func TestOutboxLeaseExpired(t *testing.T) {
+	repo := newTestOutbox(t)
+	msgs := addTestMessages(t, repo, 1)
+	if ids := claimedIDs(t, repo, 1, -time.Second); len(ids) != 1 {
+		t.Fatalf("Claim() = %v, want 1 message", ids)
+	}
+	// 租约到期后(投递实例崩溃)重新可见
+	if ids := claimedIDs(t, repo, 1, time.Minute); !equalIDs(ids, []int64{msgs[0].ID}) {
+		t.Fatalf("Claim() after the lease expired = %v, want %d", ids, msgs[0].ID)
+	}
+}
+
+func TestOutboxFailedDelivered(t *testing.T) {
+	repo := newTestOutbox(t)
+	ctx := context.Background()
+	msgs := addTestMessages(t, repo, 2)
+	claimedIDs(t, repo, 2, time.Minute)
+
+	// 失败后按 next 重新投递, 记录失败次数和截断的原因
+	cause := errors.New(strings.Repeat("x", maxOutboxError+10))
+	if err := repo.Failed(ctx, msgs[0].ID, cause, time.Now().Add(-time.Second)); err != nil {
+		t.Fatal(err)
+	}
+	claimed, err := repo.Claim(ctx, 10, time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(claimed) != 1 || claimed[0].ID != msgs[0].ID || claimed[0].Attempts != 1 {
+		t.Fatalf("Claim() after Failed() = %+v, want message %d with 1 attempt", claimed, msgs[0].ID)
+	}
+	var row OutboxMessage
+	if err := repo.data.db.First(&row, msgs[0].ID).Error; err != nil {
+		t.Fatal(err)
+	}
+	if len(row.LastError) != maxOutboxError {
+		t.Fatalf("last_error length = %d, want %d", len(row.LastError), maxOutboxError)
+	}
+
+	if err := repo.Delivered(ctx, msgs[1].ID); err != nil {
+		t.Fatal(err)
+	}
+	if n, err := repo.Backlog(ctx); err != nil || n != 1 {
+		t.Fatalf("Backlog() = %d, %v, want 1", n, err)
+	}
+}
+
+func TestOutboxAddInTx(t *testing.T) {
+	repo := newTestOutbox(t)
+	ctx := context.Background()
+	rollback := errors.New("rollback")
+	// 与内容变更同一事务, 回滚时消息一起丢弃
+	err := repo.data.ExecTx(ctx, func(ctx context.Context) error {
+		if err := repo.Add(ctx, &biz.OutboxMessage{Topic: biz.TopicContentFlow}); err != nil {
+			return err
+		}
+		return rollback
+	})
+	if !errors.Is(err, rollback) {
+		t.Fatalf("ExecTx() error = %v, want %v", err, rollback)
+	}
+	if n, err := repo.Backlog(ctx); err != nil || n != 0 {
+		t.Fatalf("Backlog() = %d, %v, want 0", n, err)
+	}
+}
+
+func TestOutboxClaimOrdered(t *testing.T) {
+	repo := newTestOutbox(t)
+	ctx := context.Background()
+	msgs := []*biz.OutboxMessage{
+		{Topic: biz.TopicContentEvent, ContentID: "a"},
+		{Topic: biz.TopicContentEvent, ContentID: "a"},
+		{Topic: biz.TopicContentEvent, ContentID: "b"},
+		// 不同类型的消息互不阻塞
+		{Topic: biz.TopicContentFlow, ContentID: "a"},
+		{Topic: biz.TopicContentEvent},
+		{Topic: biz.TopicContentEvent},
+	}
+	if err := repo.Add(ctx, msgs...); err != nil {
+		t.Fatal(err)
+	}
+	id := func(i int) int64 { return msgs[i].ID }
+
+	// 同一内容只认领最早的一条
+	want := []int64{id(0), id(2), id(3), id(4), id(5)}
+	if ids := claimedIDs(t, repo, 10, time.Minute); !equalIDs(ids, want) {
+		t.Fatalf("Claim() = %v, want %v", ids, want)
+	}
+	// 之前的消息失败后等待重试, 之后的消息仍不投递
+	if err := repo.Failed(ctx, id(0), errors.New("unavailable"), time.Now().Add(time.Hour)); err != nil {
+		t.Fatal(err)
+	}
+	if err := repo.data.db.Model(&OutboxMessage{}).Where("id <> ?", id(0)).
+		Update("next_attempt_at", time.Now().Add(-time.Second)).Error; err != nil {
+		t.Fatal(err)
+	}
+	want = []int64{id(2), id(3), id(4), id(5)}
+	if ids := claimedIDs(t, repo, 10, time.Minute); !equalIDs(ids, want) {
+		t.Fatalf("Claim() while %d is backed off = %v, want %v", id(0), ids, want)
+	}
+	// 投递成功后认领下一条
+	if err := repo.Delivered(ctx, id(0)); err != nil {
+		t.Fatal(err)
+	}
+	if ids := claimedIDs(t, repo, 10, time.Minute); !equalIDs(ids, []int64{id(1)}) {
+		t.Fatalf("Claim() after %d is delivered = %v, want %d", id(0), ids, id(1))
+	}
+}
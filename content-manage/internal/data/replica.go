@@ -0,0 +1,204 @@
+package data
+
+import (
+	"content_manage/internal/conf"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/prometheus/client_golang/prometheus"
+	"gorm.io/gorm"
+)
+
+const (
+	defaultMaxReplicaLag    = time.Second
+	defaultLagCheckInterval = time.Second
+)
+
+var (
+	// replicaLag 从库复制延迟, 无法获取时为 -1
+	replicaLag = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "content_replica_lag_seconds",
+		Help: "Replication lag of the replica database, -1 when unknown",
+	}, []string{
+		"replica",
+	})
+	// replicaFallbacks 没有可用从库时回退到主库的读取次数
+	replicaFallbacks = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "content_replica_fallbacks_total",
+		Help: "Total number of reads sent to the primary because no replica was within the lag threshold",
+	})
+)
+
+func init() {
+	prometheus.MustRegister(replicaLag, replicaFallbacks)
+}
+
+// errNotReplicating 从库没有在复制, 如复制线程已停止
+var errNotReplicating = errors.New("replication is not running")
+
+// replica 一个从库, 复制延迟在阈值内时才接收读取
+type replica struct {
+	name   string
+	db     *gorm.DB
+	usable atomic.Bool
+}
+
+// replicaSet 配置的所有从库, 轮询选择可用的从库
+type replicaSet struct {
+	replicas []*replica
+	maxLag   time.Duration
+	interval time.Duration
+	next     atomic.Uint64
+}
+
+// openReplicas 打开配置的从库, 未配置时返回 nil; 第一次检查复制延迟之前从库不接收读取
+func openReplicas(dc *conf.Data_Database) (*replicaSet, error) {
+	if len(dc.GetReplicas()) == 0 {
+		return nil, nil
+	}
+	s := &replicaSet{
+		maxLag:   defaultMaxReplicaLag,
+		interval: defaultLagCheckInterval,
+	}
+	if dc.GetMaxReplicaLag() != nil {
+		s.maxLag = dc.GetMaxReplicaLag().AsDuration()
+	}
+	if dc.GetLagCheckInterval() != nil {
+		s.interval = dc.GetLagCheckInterval().AsDuration()
+	}
+	pool := dc.GetReplicaPool()
+	for i, source := range dc.GetReplicas() {
+		name := fmt.Sprintf("replica-%d", i)
+		gdb, err := openLazy(source)
+		if err != nil {
+			s.close()
+			return nil, fmt.Errorf("open %s: %w", name, err)
+		}
+		if err := setPool(gdb, name, pool.GetMaxOpenConns(), pool.GetMaxIdleConns(), pool.GetConnMaxLifetime()); err != nil {
+			closeDB(gdb)
+			s.close()
+			return nil, err
+		}
+		s.replicas = append(s.replicas, &replica{name: name, db: gdb})
+	}
+	return s, nil
+}
+
+// pick 轮询返回一个可用的从库, 都不可用时返回 nil
+func (s *replicaSet) pick() *replica {
+	n := uint64(len(s.replicas))
+	start := s.next.Add(1)
+	for i := uint64(0); i < n; i++ {
+		if r := s.replicas[(start+i)%n]; r.usable.Load() {
+			return r
+		}
+	}
+	return nil
+}
+
+func (s *replicaSet) close() {
+	for _, r := range s.replicas {
+		closeDB(r.db)
+	}
+}
+
+// watch 定时检查各从库的复制延迟, 直到 ctx 结束
+func (s *replicaSet) watch(ctx context.Context, logger *log.Helper) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+	for {
+		for _, r := range s.replicas {
+			checkCtx, cancel := context.WithTimeout(ctx, s.interval)
+			lag, err := r.lag(checkCtx)
+			cancel()
+			if ctx.Err() != nil {
+				return
+			}
+			if err != nil {
+				replicaLag.WithLabelValues(r.name).Set(-1)
+			} else {
+				replicaLag.WithLabelValues(r.name).Set(lag.Seconds())
+			}
+			usable := err == nil && lag <= s.maxLag
+			if usable == r.usable.Load() {
+				continue
+			}
+			switch {
+			case usable:
+				logger.Infof("replica %s is usable, lag = %s", r.name, lag)
+			case err != nil:
+				logger.Errorf("replica %s is unusable, error = %v", r.name, err)
+			default:
+				logger.Warnf("replica %s lag %s exceeds %s, reading from the primary", r.name, lag, s.maxLag)
+			}
+			r.usable.Store(usable)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// lag 读取从库的复制延迟, 需要 REPLICATION CLIENT 权限; MySQL 8.0.22 之前不支持 SHOW REPLICA STATUS, 改用 SHOW SLAVE STATUS
+func (r *replica) lag(ctx context.Context) (time.Duration, error) {
+	status, err := replicaStatus(ctx, r.db, "SHOW REPLICA STATUS")
+	if err != nil && ctx.Err() == nil && !errors.Is(err, errNotReplicating) {
+		status, err = replicaStatus(ctx, r.db, "SHOW SLAVE STATUS")
+	}
+	if err != nil {
+		return 0, err
+	}
+	seconds, ok := status["Seconds_Behind_Source"]
+	if !ok {
+		seconds, ok = status["Seconds_Behind_Master"]
+	}
+	// 复制线程停止时为 NULL
+	if !ok || !seconds.Valid {
+		return 0, errNotReplicating
+	}
+	n, err := strconv.ParseInt(seconds.String, 10, 64)
+	if err != nil {
+		return 0, err
+	}
+	return time.Duration(n) * time.Second, nil
+}
+
+// replicaStatus 以列名到值的映射返回复制状态, 不是从库时返回 errNotReplicating
+func replicaStatus(ctx context.Context, db *gorm.DB, query string) (map[string]sql.NullString, error) {
+	rows, err := db.WithContext(ctx).Raw(query).Rows()
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	columns, err := rows.Columns()
+	if err != nil {
+		return nil, err
+	}
+	if !rows.Next() {
+		if err := rows.Err(); err != nil {
+			return nil, err
+		}
+		return nil, errNotReplicating
+	}
+	values := make([]sql.NullString, len(columns))
+	dest := make([]interface{}, len(columns))
+	for i := range values {
+		dest[i] = &values[i]
+	}
+	if err := rows.Scan(dest...); err != nil {
+		return nil, err
+	}
+	status := make(map[string]sql.NullString, len(columns))
+	for i, column := range columns {
+		status[column] = values[i]
+	}
+	return status, nil
+}
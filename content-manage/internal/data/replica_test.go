@@ -0,0 +1,130 @@
+package data
+
+import (
+	"content_manage/internal/biz"
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	shard "github.com/zerokkcoder/content-shard"
+	"gorm.io/gorm"
+)
+
+// newTestReplicas 给 d 配置 n 个 sqlite 从库, 初始都不可用
+func newTestReplicas(t *testing.T, d *Data, n int) *replicaSet {
+	t.Helper()
+	s := &replicaSet{maxLag: time.Second, interval: 10 * time.Millisecond}
+	for i := 0; i < n; i++ {
+		s.replicas = append(s.replicas, &replica{name: "replica-test", db: newTestDB(t, shard.MustNew(shard.DefaultConfig()))})
+	}
+	d.replicas = s
+	return s
+}
+
+func samePool(a, b *gorm.DB) bool {
+	return a.Statement.ConnPool == b.Statement.ConnPool
+}
+
+func TestReplicaSetPick(t *testing.T) {
+	d := newTestData(t, nil)
+	s := newTestReplicas(t, d, 3)
+	if r := s.pick(); r != nil {
+		t.Fatalf("pick() = %s, want nil before the first lag check", r.name)
+	}
+
+	// 都可用时依次轮询
+	for _, r := range s.replicas {
+		r.usable.Store(true)
+	}
+	picked := map[*replica]int{}
+	for i := 0; i < 9; i++ {
+		picked[s.pick()]++
+	}
+	for i, r := range s.replicas {
+		if picked[r] != 3 {
+			t.Fatalf("replica %d picked %d of 9 times, want 3", i, picked[r])
+		}
+	}
+
+	// 跳过不可用的从库
+	s.replicas[1].usable.Store(false)
+	picked = map[*replica]int{}
+	for i := 0; i < 9; i++ {
+		picked[s.pick()]++
+	}
+	if len(picked) != 2 || picked[s.replicas[0]] == 0 || picked[s.replicas[2]] == 0 {
+		t.Fatalf("pick() = %v, want only replicas 0 and 2", picked)
+	}
+}
+
+func TestReadDBFallback(t *testing.T) {
+	d := newTestData(t, nil)
+	ctx := context.Background()
+
+	// 未配置从库时读主库, 不计入回退
+	fallbacks := testutil.ToFloat64(replicaFallbacks)
+	if !samePool(d.ReadDB(ctx), d.db) {
+		t.Fatal("ReadDB() without replicas did not use the primary")
+	}
+	if n := testutil.ToFloat64(replicaFallbacks); n != fallbacks {
+		t.Fatalf("fallbacks = %v, want %v without replicas", n, fallbacks)
+	}
+
+	s := newTestReplicas(t, d, 1)
+	s.replicas[0].usable.Store(true)
+	if !samePool(d.ReadDB(ctx), s.replicas[0].db) {
+		t.Fatal("ReadDB() did not use the usable replica")
+	}
+	// 读己之写和事务中使用主库
+	if !samePool(d.ReadDB(biz.WithPrimary(ctx)), d.db) {
+		t.Fatal("ReadDB() with WithPrimary did not use the primary")
+	}
+	err := d.ExecTx(ctx, func(ctx context.Context) error {
+		if !samePool(d.ReadDB(ctx), d.DB(ctx)) {
+			t.Error("ReadDB() in a transaction did not use the transaction")
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// 从库延迟超过阈值时回退到主库并计数
+	s.replicas[0].usable.Store(false)
+	if !samePool(d.ReadDB(ctx), d.db) {
+		t.Fatal("ReadDB() without a usable replica did not use the primary")
+	}
+	if n := testutil.ToFloat64(replicaFallbacks); n != fallbacks+1 {
+		t.Fatalf("fallbacks = %v, want %v", n, fallbacks+1)
+	}
+}
+
+func TestReplicaWatchUnusable(t *testing.T) {
+	d := newTestData(t, nil)
+	s := newTestReplicas(t, d, 1)
+	r := s.replicas[0]
+	r.usable.Store(true)
+
+	// sqlite 不支持查询复制状态, 延迟未知时不再接收读取
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		s.watch(ctx, log.NewHelper(log.NewStdLogger(io.Discard)))
+	}()
+	deadline := time.Now().Add(3 * time.Second)
+	for r.usable.Load() {
+		if time.Now().After(deadline) {
+			t.Fatal("replica still usable after a failed lag check")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	cancel()
+	<-done
+	if lag := testutil.ToFloat64(replicaLag.WithLabelValues(r.name)); lag != -1 {
+		t.Fatalf("replica lag = %v, want -1 when unknown", lag)
+	}
+}
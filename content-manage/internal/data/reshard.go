@@ -0,0 +1,571 @@
+package data
+
+import (
+	"content_manage/internal/biz"
+	"content_manage/internal/conf"
+	"context"
+	"errors"
+	"regexp"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/prometheus/client_golang/prometheus"
+	shard "github.com/zerokkcoder/content-shard"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const defaultReshardPollInterval = 5 * time.Second
+
+var (
+	// reshardPhase 当前重分片阶段, 当前阶段为 1, 其余为 0
+	reshardPhase = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "content_reshard_phase",
+		Help: "Current phase of the reshard job, 1 for the current phase",
+	}, []string{
+		"phase",
+	})
+	// reshardRows 重分片任务的进度, kind 为 total、copied、verified、mismatched 或 cleaned
+	reshardRows = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "content_reshard_rows",
+		Help: "Progress of the reshard job in rows",
+	}, []string{
+		"kind",
+	})
+	// reshardCheckpointAge 距离任务最近一次保存进度的时间, 阶段推进中持续增长说明任务停滞
+	reshardCheckpointAge = prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "content_reshard_checkpoint_age_seconds",
+		Help: "Seconds since the reshard job last saved its checkpoint",
+	})
+	// reshardSkipped 复制时因之后已有写入而跳过的行数
+	reshardSkipped = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "content_reshard_skipped_rows_total",
+		Help: "Total number of rows skipped by backfill and verify because they were written after being read",
+	})
+)
+
+var reshardPhases = []biz.ReshardPhase{
+	biz.ReshardPhaseDualWrite,
+	biz.ReshardPhaseBackfill,
+	biz.ReshardPhaseVerify,
+	biz.ReshardPhaseCutover,
+	biz.ReshardPhaseDone,
+	biz.ReshardPhaseAborted,
+}
+
+func init() {
+	prometheus.MustRegister(reshardPhase, reshardRows, reshardCheckpointAge, reshardSkipped)
+}
+
+// ReshardJob 重分片任务进度
+type ReshardJob struct {
+	ID             int64     `gorm:"column:id;primaryKey"`
+	SourceVersion  string    `gorm:"column:source_version"`   // 原分片拓扑版本
+	TargetVersion  string    `gorm:"column:target_version"`   // 目标分片拓扑版本
+	Phase          string    `gorm:"column:phase"`            // 当前阶段
+	LastID         int64     `gorm:"column:last_id"`          // 当前阶段处理到的索引表 id
+	Total          int64     `gorm:"column:total"`            // 需要回填的行数
+	Copied         int64     `gorm:"column:copied"`           // 已回填行数
+	Verified       int64     `gorm:"column:verified"`         // 已校验行数
+	Mismatched     int64     `gorm:"column:mismatched"`       // 校验不一致并已修复的行数
+	Cleaned        int64     `gorm:"column:cleaned"`          // 已清理的旧表残留行数
+	PhaseDone      bool      `gorm:"column:phase_done"`       // 当前阶段是否已处理完全部行
+	PhaseChangedAt time.Time `gorm:"column:phase_changed_at"` // 进入当前阶段的时间
+	CreatedAt      time.Time `gorm:"column:created_at"`
+	UpdatedAt      time.Time `gorm:"column:updated_at"`
+}
+
+func (*ReshardJob) TableName() string {
+	return "cms_content.t_reshard_jobs"
+}
+
+// reshardState 进行中的重分片任务, 决定详情表的读写路由
+type reshardState struct {
+	source *shard.Router
+	target *shard.Router
+	poll   time.Duration
+	phase  atomic.Value // biz.ReshardPhase
+}
+
+func newReshardState(c *conf.Data, source *shard.Router) (*reshardState, error) {
+	rc := c.GetReshard()
+	if rc.GetTarget() == nil {
+		return nil, nil
+	}
+	target, err := newRouter(rc.GetTarget())
+	if err != nil {
+		return nil, err
+	}
+	poll := defaultReshardPollInterval
+	if rc.GetPollInterval() != nil {
+		poll = rc.GetPollInterval().AsDuration()
+	}
+	s := &reshardState{source: source, target: target, poll: poll}
+	s.phase.Store(biz.ReshardPhase(""))
+	return s, nil
+}
+
+func (s *reshardState) current() biz.ReshardPhase {
+	return s.phase.Load().(biz.ReshardPhase)
+}
+
+// readRouter 切读之后从新布局读取
+func (s *reshardState) readRouter() *shard.Router {
+	switch s.current() {
+	case biz.ReshardPhaseCutover, biz.ReshardPhaseDone:
+		return s.target
+	}
+	return s.source
+}
+
+// writeTables 双写阶段同时写入新旧布局, 新旧布局是同一张表时只写一次
+func (s *reshardState) writeTables(contentID string) []string {
+	switch s.current() {
+	case biz.ReshardPhaseDualWrite, biz.ReshardPhaseBackfill, biz.ReshardPhaseVerify, biz.ReshardPhaseCutover:
+		source, target := s.source.Table(contentID), s.target.Table(contentID)
+		if source == target {
+			return []string{source}
+		}
+		return []string{source, target}
+	case biz.ReshardPhaseDone:
+		return []string{s.target.Table(contentID)}
+	}
+	return []string{s.source.Table(contentID)}
+}
+
+// refresh 从任务表加载当前阶段
+func (s *reshardState) refresh(ctx context.Context, db *gorm.DB) error {
+	var job ReshardJob
+	err := db.WithContext(ctx).
+		Where("source_version = ? AND target_version = ?", s.source.Version(), s.target.Version()).
+		Order("id DESC").First(&job).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		s.phase.Store(biz.ReshardPhase(""))
+		observeReshard(nil)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	s.phase.Store(biz.ReshardPhase(job.Phase))
+	observeReshard(&job)
+	return nil
+}
+
+// observeReshard 把任务进度记录到监控, job 为 nil 时清零
+func observeReshard(job *ReshardJob) {
+	if job == nil {
+		job = &ReshardJob{}
+	}
+	for _, phase := range reshardPhases {
+		if string(phase) == job.Phase {
+			reshardPhase.WithLabelValues(string(phase)).Set(1)
+		} else {
+			reshardPhase.WithLabelValues(string(phase)).Set(0)
+		}
+	}
+	reshardRows.WithLabelValues("total").Set(float64(job.Total))
+	reshardRows.WithLabelValues("copied").Set(float64(job.Copied))
+	reshardRows.WithLabelValues("verified").Set(float64(job.Verified))
+	reshardRows.WithLabelValues("mismatched").Set(float64(job.Mismatched))
+	reshardRows.WithLabelValues("cleaned").Set(float64(job.Cleaned))
+	if job.UpdatedAt.IsZero() {
+		reshardCheckpointAge.Set(0)
+	} else {
+		reshardCheckpointAge.Set(time.Since(job.UpdatedAt).Seconds())
+	}
+}
+
+// watch 定时刷新阶段, 直到 ctx 结束
+func (s *reshardState) watch(ctx context.Context, db *gorm.DB, logger *log.Helper) {
+	ticker := time.NewTicker(s.poll)
+	defer ticker.Stop()
+	for {
+		prev := s.current()
+		if err := s.refresh(ctx, db); err != nil {
+			logger.Errorf("reshard refresh phase error = %v", err)
+		} else if phase := s.current(); phase != prev {
+			logger.Infof("reshard %s -> %s phase = %s", s.source.Version(), s.target.Version(), phase)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+type reshardRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewReshardRepo .
+func NewReshardRepo(data *Data, logger log.Logger) biz.ReshardRepo {
+	return &reshardRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func (r *reshardRepo) state() (*reshardState, error) {
+	if r.data.reshard == nil {
+		return nil, errors.New("reshard target is not configured")
+	}
+	return r.data.reshard, nil
+}
+
+func (r *reshardRepo) PollInterval() time.Duration {
+	if r.data.reshard == nil {
+		return defaultReshardPollInterval
+	}
+	return r.data.reshard.poll
+}
+
+func (r *reshardRepo) Current(ctx context.Context) (*biz.ReshardJob, error) {
+	s, err := r.state()
+	if err != nil {
+		return nil, err
+	}
+	var job ReshardJob
+	err = r.data.DB(ctx).
+		Where("source_version = ? AND target_version = ?", s.source.Version(), s.target.Version()).
+		Order("id DESC").First(&job).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return toBizReshardJob(&job), nil
+}
+
+func (r *reshardRepo) Start(ctx context.Context) (*biz.ReshardJob, error) {
+	s, err := r.state()
+	if err != nil {
+		return nil, err
+	}
+	sourceTables := make(map[string]bool)
+	for _, table := range s.source.Tables() {
+		sourceTables[table] = true
+	}
+	// 新布局中不存在的表按旧表结构在其所在的库中创建, 新旧表可能不在同一个库, 不能使用 CREATE TABLE LIKE
+	var ddl string
+	for _, table := range s.target.Tables() {
+		if sourceTables[table] {
+			continue
+		}
+		if ddl == "" {
+			if ddl, err = r.showCreateTable(ctx, s.source.TableAt(0)); err != nil {
+				return nil, err
+			}
+		}
+		target, err := r.data.detailDB(ctx, table)
+		if err != nil {
+			return nil, err
+		}
+		if err := target.Exec(createTableAs(ddl, table)).Error; err != nil {
+			r.log.WithContext(ctx).Errorf("reshardRepo create table %s error = %v", table, err)
+			return nil, r.data.shardError(table, err)
+		}
+	}
+	db := r.data.DB(ctx)
+	job := ReshardJob{
+		SourceVersion:  s.source.Version(),
+		TargetVersion:  s.target.Version(),
+		Phase:          string(biz.ReshardPhaseDualWrite),
+		PhaseChangedAt: time.Now(),
+	}
+	if err := db.Create(&job).Error; err != nil {
+		return nil, err
+	}
+	s.phase.Store(biz.ReshardPhaseDualWrite)
+	return toBizReshardJob(&job), nil
+}
+
+// showCreateTable 从详情表所在的库读取建表语句
+func (r *reshardRepo) showCreateTable(ctx context.Context, table string) (string, error) {
+	db, err := r.data.detailDB(ctx, table)
+	if err != nil {
+		return "", err
+	}
+	var name, ddl string
+	if err := db.Raw("SHOW CREATE TABLE "+quoteTable(table)).Row().Scan(&name, &ddl); err != nil {
+		return "", r.data.shardError(table, err)
+	}
+	return ddl, nil
+}
+
+// createTableHeader 建表语句开头的表名, 以及需要重新计数的自增起始值
+var (
+	createTableHeader = regexp.MustCompile("^CREATE TABLE `[^`]+`")
+	autoIncrement     = regexp.MustCompile(` AUTO_INCREMENT=\d+`)
+)
+
+// createTableAs 把 SHOW CREATE TABLE 的结果改写为创建 table 的语句
+func createTableAs(ddl, table string) string {
+	ddl = createTableHeader.ReplaceAllLiteralString(ddl, "CREATE TABLE IF NOT EXISTS "+quoteTable(table))
+	return autoIncrement.ReplaceAllString(ddl, "")
+}
+
+// quoteTable 为 库.表 形式的表名加上反引号
+func quoteTable(table string) string {
+	parts := strings.Split(table, ".")
+	for i, part := range parts {
+		parts[i] = "`" + part + "`"
+	}
+	return strings.Join(parts, ".")
+}
+
+func (r *reshardRepo) Save(ctx context.Context, job *biz.ReshardJob) error {
+	return r.data.DB(ctx).Model(&ReshardJob{ID: job.ID}).Select("*").Omit("id", "created_at").
+		Updates(&ReshardJob{
+			SourceVersion:  job.SourceVersion,
+			TargetVersion:  job.TargetVersion,
+			Phase:          string(job.Phase),
+			LastID:         job.LastID,
+			Total:          job.Total,
+			Copied:         job.Copied,
+			Verified:       job.Verified,
+			Mismatched:     job.Mismatched,
+			Cleaned:        job.Cleaned,
+			PhaseDone:      job.PhaseDone,
+			PhaseChangedAt: job.PhaseChangedAt,
+		}).Error
+}
+
+func (r *reshardRepo) CountIndex(ctx context.Context) (int64, error) {
+	var total int64
+	err := r.data.DB(ctx).Unscoped().Model(&IdxContentDetail{}).Count(&total).Error
+	return total, err
+}
+
+// nextIndexBatch 按 id 顺序读取下一批索引, 回收站中的内容同样需要迁移
+func (r *reshardRepo) nextIndexBatch(ctx context.Context, lastID int64, batch int) ([]*IdxContentDetail, error) {
+	var indices []*IdxContentDetail
+	err := r.data.DB(ctx).Unscoped().Where("id > ?", lastID).Order("id").Limit(batch).Find(&indices).Error
+	return indices, err
+}
+
+// loadDetails 按表分组批量读取详情, 返回 content_id -> 详情
+func (r *reshardRepo) loadDetails(ctx context.Context, router *shard.Router, contentIDs []string) (map[string]*ContentDetail, error) {
+	groups := make(map[string][]string)
+	for _, contentID := range contentIDs {
+		table := router.Table(contentID)
+		groups[table] = append(groups[table], contentID)
+	}
+	details := make(map[string]*ContentDetail, len(contentIDs))
+	for table, ids := range groups {
+		var rows []*ContentDetail
+		query, err := r.data.detailDB(ctx, table)
+		if err != nil {
+			return nil, err
+		}
+		if err := query.Unscoped().Where("content_id IN ?", ids).Find(&rows).Error; err != nil {
+			return nil, r.data.shardError(table, err)
+		}
+		for _, row := range rows {
+			details[row.ContentID] = row
+		}
+	}
+	return details, nil
+}
+
+// copyDetails 用旧布局的详情覆盖新布局中的同一行, 删除和写入在同一个事务中.
+// 先锁定索引行, 期间同一内容的更新和删除会等待; details 读取之后已有写入的行与索引的版本或删除状态不一致,
+// 这些写入已经双写到新布局, 跳过不覆盖, 由校验阶段再次比较
+func (r *reshardRepo) copyDetails(ctx context.Context, target *shard.Router, details []*ContentDetail) error {
+	return r.data.ExecTx(ctx, func(ctx context.Context) error {
+		contentIDs := make([]string, 0, len(details))
+		for _, detail := range details {
+			contentIDs = append(contentIDs, detail.ContentID)
+		}
+		var indices []*IdxContentDetail
+		if err := r.data.DB(ctx).Unscoped().Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("content_id IN ?", contentIDs).Find(&indices).Error; err != nil {
+			return err
+		}
+		current := make(map[string]*IdxContentDetail, len(indices))
+		for _, idx := range indices {
+			current[idx.ContentID] = idx
+		}
+		groups := make(map[string][]*ContentDetail)
+		for _, detail := range details {
+			idx, ok := current[detail.ContentID]
+			// 索引不存在说明内容已被彻底删除
+			if !ok || idx.Version != detail.Version || idx.DeletedAt.Valid != detail.DeletedAt.Valid {
+				reshardSkipped.Inc()
+				continue
+			}
+			row := *detail
+			// 各详情表自增 id 相互独立, 由新表重新分配
+			row.ID = 0
+			table := target.Table(row.ContentID)
+			groups[table] = append(groups[table], &row)
+		}
+		for table, rows := range groups {
+			ids := make([]string, 0, len(rows))
+			for _, row := range rows {
+				ids = append(ids, row.ContentID)
+			}
+			db, err := r.data.writeDetailDB(ctx, table, ids)
+			if err != nil {
+				return err
+			}
+			if err := db.Unscoped().Where("content_id IN ?", ids).Delete(&ContentDetail{}).Error; err != nil {
+				return r.data.shardError(table, err)
+			}
+			// 链式查询会复用条件, 写入使用单独的查询
+			db, err = r.data.detailDB(ctx, table)
+			if err != nil {
+				return err
+			}
+			if err := db.Create(&rows).Error; err != nil {
+				return r.data.shardError(table, err)
+			}
+		}
+		return nil
+	})
+}
+
+// movedContentIDs 返回新旧布局不在同一张表的 content_id
+func movedContentIDs(s *reshardState, indices []*IdxContentDetail) []string {
+	contentIDs := make([]string, 0, len(indices))
+	for _, idx := range indices {
+		if s.source.Table(idx.ContentID) != s.target.Table(idx.ContentID) {
+			contentIDs = append(contentIDs, idx.ContentID)
+		}
+	}
+	return contentIDs
+}
+
+func (r *reshardRepo) Backfill(ctx context.Context, job *biz.ReshardJob, batch int) (int64, int, error) {
+	s, err := r.state()
+	if err != nil {
+		return 0, 0, err
+	}
+	indices, err := r.nextIndexBatch(ctx, job.LastID, batch)
+	if err != nil || len(indices) == 0 {
+		return job.LastID, 0, err
+	}
+	sources, err := r.loadDetails(ctx, s.source, movedContentIDs(s, indices))
+	if err != nil {
+		return 0, 0, err
+	}
+	details := make([]*ContentDetail, 0, len(sources))
+	for _, detail := range sources {
+		details = append(details, detail)
+	}
+	if len(details) > 0 {
+		if err := r.copyDetails(ctx, s.target, details); err != nil {
+			r.log.WithContext(ctx).Errorf("reshardRepo Backfill error = %v", err)
+			return 0, 0, err
+		}
+	}
+	return indices[len(indices)-1].ID, len(details), nil
+}
+
+func (r *reshardRepo) Verify(ctx context.Context, job *biz.ReshardJob, batch int) (int64, int, int, error) {
+	s, err := r.state()
+	if err != nil {
+		return 0, 0, 0, err
+	}
+	indices, err := r.nextIndexBatch(ctx, job.LastID, batch)
+	if err != nil || len(indices) == 0 {
+		return job.LastID, 0, 0, err
+	}
+	contentIDs := movedContentIDs(s, indices)
+	sources, err := r.loadDetails(ctx, s.source, contentIDs)
+	if err != nil {
+		return 0, 0, 0, err
+	}
+	targets, err := r.loadDetails(ctx, s.target, contentIDs)
+	if err != nil {
+		return 0, 0, 0, err
+	}
+	var mismatched []*ContentDetail
+	for contentID, source := range sources {
+		if target, ok := targets[contentID]; !ok || !sameDetail(source, target) {
+			mismatched = append(mismatched, source)
+		}
+	}
+	if len(mismatched) > 0 {
+		r.log.WithContext(ctx).Warnf("reshardRepo Verify repair %d rows after id %d", len(mismatched), job.LastID)
+		if err := r.copyDetails(ctx, s.target, mismatched); err != nil {
+			return 0, 0, 0, err
+		}
+	}
+	return indices[len(indices)-1].ID, len(indices), len(mismatched), nil
+}
+
+func (r *reshardRepo) Cleanup(ctx context.Context, job *biz.ReshardJob, batch int) (int64, int, error) {
+	s, err := r.state()
+	if err != nil {
+		return 0, 0, err
+	}
+	targetTables := make(map[string]bool)
+	for _, table := range s.target.Tables() {
+		targetTables[table] = true
+	}
+	indices, err := r.nextIndexBatch(ctx, job.LastID, batch)
+	if err != nil || len(indices) == 0 {
+		return job.LastID, 0, err
+	}
+	// 只清理被新布局复用的旧表, 其余旧表由 DBA 直接下线
+	groups := make(map[string][]string)
+	for _, contentID := range movedContentIDs(s, indices) {
+		if table := s.source.Table(contentID); targetTables[table] {
+			groups[table] = append(groups[table], contentID)
+		}
+	}
+	var cleaned int64
+	for table, contentIDs := range groups {
+		query, err := r.data.detailDB(ctx, table)
+		if err != nil {
+			return 0, 0, err
+		}
+		result := query.Unscoped().Where("content_id IN ?", contentIDs).Delete(&ContentDetail{})
+		if result.Error != nil {
+			return 0, 0, r.data.shardError(table, result.Error)
+		}
+		cleaned += result.RowsAffected
+	}
+	return indices[len(indices)-1].ID, int(cleaned), nil
+}
+
+// sameDetail 比较除自增 id 和更新时间外的所有字段
+func sameDetail(a, b *ContentDetail) bool {
+	x, y := *a, *b
+	if !x.CreatedAt.Equal(y.CreatedAt) {
+		return false
+	}
+	if x.DeletedAt.Valid != y.DeletedAt.Valid || !x.DeletedAt.Time.Equal(y.DeletedAt.Time) {
+		return false
+	}
+	x.ID, y.ID = 0, 0
+	x.CreatedAt, y.CreatedAt = time.Time{}, time.Time{}
+	x.UpdatedAt, y.UpdatedAt = time.Time{}, time.Time{}
+	x.DeletedAt, y.DeletedAt = gorm.DeletedAt{}, gorm.DeletedAt{}
+	return x == y
+}
+
+func toBizReshardJob(job *ReshardJob) *biz.ReshardJob {
+	return &biz.ReshardJob{
+		ID:             job.ID,
+		SourceVersion:  job.SourceVersion,
+		TargetVersion:  job.TargetVersion,
+		Phase:          biz.ReshardPhase(job.Phase),
+		LastID:         job.LastID,
+		Total:          job.Total,
+		Copied:         job.Copied,
+		Verified:       job.Verified,
+		Mismatched:     job.Mismatched,
+		Cleaned:        job.Cleaned,
+		PhaseDone:      job.PhaseDone,
+		PhaseChangedAt: job.PhaseChangedAt,
+		CreatedAt:      job.CreatedAt,
+		UpdatedAt:      job.UpdatedAt,
+	}
+}
@@ -0,0 +1,134 @@
+package data
+
+import (
+	"content_manage/internal/biz"
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+)
+
+// ContentRevision 内容的历史版本, 写入后不再修改
+type ContentRevision struct {
+	ID            int64     `gorm:"column:id;primaryKey"`
+	ContentID     int64     `gorm:"column:content_id"`     // 索引表 id
+	Revision      int32     `gorm:"column:revision"`       // 版本号, 同一内容内从 1 递增
+	Editor        string    `gorm:"column:editor"`         // 修改人
+	ChangedFields string    `gorm:"column:changed_fields"` // 修改的字段, 逗号分隔
+	Snapshot      string    `gorm:"column:snapshot"`       // 修改后的完整内容, json
+	CreatedAt     time.Time `gorm:"column:created_at"`
+}
+
+func (*ContentRevision) TableName() string {
+	return "cms_content.t_content_revisions"
+}
+
+type revisionRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewRevisionRepo .
+func NewRevisionRepo(data *Data, logger log.Logger) biz.RevisionRepo {
+	return &revisionRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func (r *revisionRepo) Create(ctx context.Context, rev *biz.Revision) error {
+	snapshot, err := json.Marshal(rev.Content)
+	if err != nil {
+		return err
+	}
+	row := ContentRevision{
+		ContentID:     rev.ContentID,
+		Revision:      rev.Revision,
+		Editor:        rev.Editor,
+		ChangedFields: strings.Join(rev.ChangedFields, ","),
+		Snapshot:      string(snapshot),
+		CreatedAt:     rev.CreatedAt,
+	}
+	if err := r.data.DB(ctx).Create(&row).Error; err != nil {
+		r.log.WithContext(ctx).Errorf("revisionRepo Create error = %v\n", err)
+		return err
+	}
+	rev.CreatedAt = row.CreatedAt
+	return nil
+}
+
+func (r *revisionRepo) Latest(ctx context.Context, contentID int64) (*biz.Revision, error) {
+	var row ContentRevision
+	err := r.data.DB(ctx).Omit("snapshot").
+		Where("content_id = ?", contentID).Order("revision DESC").First(&row).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return toBizRevision(&row)
+}
+
+func (r *revisionRepo) List(ctx context.Context, contentID int64, page, pageSize int32) ([]*biz.Revision, int64, error) {
+	query := r.data.ReadDB(ctx).Model(&ContentRevision{}).Where("content_id = ?", contentID)
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+	var rows []*ContentRevision
+	if err := query.Omit("snapshot").
+		Order("revision DESC").
+		Offset(int((page - 1) * pageSize)).
+		Limit(int(pageSize)).
+		Find(&rows).Error; err != nil {
+		r.log.WithContext(ctx).Errorf("revisionRepo List error = %v\n", err)
+		return nil, 0, err
+	}
+	revisions := make([]*biz.Revision, 0, len(rows))
+	for _, row := range rows {
+		rev, err := toBizRevision(row)
+		if err != nil {
+			return nil, 0, err
+		}
+		revisions = append(revisions, rev)
+	}
+	return revisions, total, nil
+}
+
+func (r *revisionRepo) Get(ctx context.Context, contentID int64, revision int32) (*biz.Revision, error) {
+	var row ContentRevision
+	err := r.data.ReadDB(ctx).Where("content_id = ? AND revision = ?", contentID, revision).First(&row).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, biz.ErrRevisionNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return toBizRevision(&row)
+}
+
+// toBizRevision 未查询快照时 Content 为 nil
+func toBizRevision(row *ContentRevision) (*biz.Revision, error) {
+	rev := &biz.Revision{
+		ContentID: row.ContentID,
+		Revision:  row.Revision,
+		Editor:    row.Editor,
+		CreatedAt: row.CreatedAt,
+	}
+	if row.ChangedFields != "" {
+		rev.ChangedFields = strings.Split(row.ChangedFields, ",")
+	}
+	if row.Snapshot != "" {
+		var content biz.Content
+		if err := json.Unmarshal([]byte(row.Snapshot), &content); err != nil {
+			return nil, err
+		}
+		rev.Content = &content
+	}
+	return rev, nil
+}
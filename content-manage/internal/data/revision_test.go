@@ -0,0 +1,76 @@
+package data
+
+import (
+	"content_manage/internal/biz"
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestRollbackContent(t *testing.T) {
+	uc, _ := newTestUsecase(t)
+	ctx := context.Background()
+	id := createUsecaseContent(t, uc, "alpha")
+	if _, err := uc.UpdateContent(ctx, &biz.Content{ID: id, Title: "bravo"}, nil, "alice"); err != nil {
+		t.Fatal(err)
+	}
+	updated, err := uc.UpdateContent(ctx, &biz.Content{ID: id, Category: "news"}, []string{"category"}, "bob")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if updated.Version != 3 {
+		t.Fatalf("version after 2 updates = %d, want 3", updated.Version)
+	}
+
+	// 第一次更新前的内容保存为版本 1
+	diffs, err := uc.DiffRevisions(ctx, id, 1, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fields := fieldNames(diffs); !reflect.DeepEqual(fields, []string{"title", "category"}) {
+		t.Fatalf("DiffRevisions(1, 3) fields = %v, want [title category]", fields)
+	}
+
+	rev, err := uc.RollbackContent(ctx, id, 1, "carol")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rev == nil || rev.Revision != 4 || rev.Editor != "carol" || !reflect.DeepEqual(rev.ChangedFields, []string{"title", "category"}) {
+		t.Fatalf("RollbackContent() revision = %+v, want revision 4 by carol changing title and category", rev)
+	}
+	content, err := uc.GetContent(ctx, id, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 回滚是一次新的修改, 版本号递增而不是恢复为快照中的版本号
+	if content.Title != "alpha" || content.Category != "" || content.Version != 4 {
+		t.Fatalf("content after rollback = title %q, category %q, version %d, want alpha, empty, 4", content.Title, content.Category, content.Version)
+	}
+	revisions, total, err := uc.ListRevisions(ctx, id, 1, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if total != 4 || len(revisions) != 4 || revisions[0].Revision != 4 {
+		t.Fatalf("ListRevisions() = %d revisions, total %d, want 4 newest first", len(revisions), total)
+	}
+
+	// 已经与目标版本一致时不产生新版本
+	if rev, err := uc.RollbackContent(ctx, id, 1, "carol"); err != nil || rev != nil {
+		t.Fatalf("RollbackContent() to the current state = %+v, %v, want nil", rev, err)
+	}
+	if content, err := uc.GetContent(ctx, id, ""); err != nil || content.Version != 4 {
+		t.Fatalf("version after a no-op rollback = %d, %v, want 4", content.Version, err)
+	}
+	if _, err := uc.RollbackContent(ctx, id, 9, "carol"); !errors.Is(err, biz.ErrRevisionNotFound) {
+		t.Fatalf("RollbackContent() to a missing revision error = %v, want ErrRevisionNotFound", err)
+	}
+}
+
+func fieldNames(diffs []*biz.FieldDiff) []string {
+	fields := make([]string, 0, len(diffs))
+	for _, d := range diffs {
+		fields = append(fields, d.Field)
+	}
+	return fields
+}
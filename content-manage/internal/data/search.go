@@ -0,0 +1,209 @@
+package data
+
+import (
+	"content_manage/internal/biz"
+	"content_manage/internal/conf"
+	"content_manage/internal/search"
+	"context"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// 快照默认写入间隔
+const defaultSaveInterval = time.Minute
+
+type searchRepo struct {
+	index *search.Index
+	path  string
+	log   *log.Helper
+	// mu 串行化快照文件的读写
+	mu sync.Mutex
+	// savedAt 本实例上次写入或加载的快照修改时间
+	savedAt time.Time
+	// changeMu 保护 pending 和 reset, 并串行化索引变更与快照加载, 加载期间的变更不会丢失
+	changeMu sync.Mutex
+	// pending 上次写快照后本实例修改的文档, 值为 nil 表示删除; 加载其他进程写入的快照后重放
+	pending map[int64]*search.Document
+	// reset 上次写快照后本实例是否清空过索引
+	reset bool
+}
+
+// NewSearchRepo 创建全文索引, 启动时加载快照并定期写回
+func NewSearchRepo(c *conf.Data, logger log.Logger) (biz.SearchRepo, func(), error) {
+	helper := log.NewHelper(logger)
+	s := &searchRepo{
+		index:   search.NewIndex(),
+		path:    c.GetSearch().GetPath(),
+		log:     helper,
+		pending: make(map[int64]*search.Document),
+	}
+	if s.path == "" {
+		helper.Warn("search index path is empty, the index will be lost on restart")
+		return s, func() {}, nil
+	}
+	if err := s.load(); err != nil {
+		return nil, nil, err
+	}
+	helper.Infof("search index loaded from %s, documents = %d", s.path, s.index.Len())
+
+	interval := defaultSaveInterval
+	if d := c.GetSearch().GetSaveInterval(); d != nil && d.AsDuration() > 0 {
+		interval = d.AsDuration()
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		s.watch(ctx, interval)
+	}()
+	cleanup := func() {
+		cancel()
+		<-done
+		if err := s.flush(context.Background()); err != nil {
+			helper.Errorf("save search index error = %v", err)
+		}
+	}
+	return s, cleanup, nil
+}
+
+// watch 定期写入快照
+func (s *searchRepo) watch(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		if err := s.flush(ctx); err != nil {
+			s.log.Errorf("save search index error = %v", err)
+		}
+	}
+}
+
+// flush 快照被重建命令或共用路径的其他实例更新过时, 先加载并重放本实例的变更, 再写入快照, 不覆盖其他进程的写入
+func (s *searchRepo) flush(ctx context.Context) error {
+	if s.rebuilt() {
+		if err := s.load(); err != nil {
+			return err
+		}
+		s.log.Infof("search index reloaded from %s, documents = %d", s.path, s.index.Len())
+	}
+	return s.Save(ctx)
+}
+
+// rebuilt 快照文件是否被其他进程更新过
+func (s *searchRepo) rebuilt() bool {
+	info, err := os.Stat(s.path)
+	if err != nil {
+		return false
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return info.ModTime().After(s.savedAt)
+}
+
+// load 加载快照, 然后重放本实例尚未写入快照的变更
+func (s *searchRepo) load() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.changeMu.Lock()
+	defer s.changeMu.Unlock()
+	if err := s.index.Load(s.path); err != nil {
+		return err
+	}
+	if info, err := os.Stat(s.path); err == nil {
+		s.savedAt = info.ModTime()
+	}
+	if s.reset {
+		s.index.Reset()
+	}
+	for id, doc := range s.pending {
+		if doc == nil {
+			s.index.Remove(id)
+		} else {
+			s.index.Put(*doc)
+		}
+	}
+	return nil
+}
+
+func (s *searchRepo) Index(ctx context.Context, content *biz.Content) error {
+	doc := search.Document{
+		ID:          content.ID,
+		ContentID:   content.ContentID,
+		Title:       content.Title,
+		Description: content.Description,
+	}
+	s.changeMu.Lock()
+	defer s.changeMu.Unlock()
+	s.index.Put(doc)
+	s.pending[doc.ID] = &doc
+	return nil
+}
+
+func (s *searchRepo) Remove(ctx context.Context, id int64) error {
+	s.changeMu.Lock()
+	defer s.changeMu.Unlock()
+	s.index.Remove(id)
+	s.pending[id] = nil
+	return nil
+}
+
+func (s *searchRepo) Search(ctx context.Context, query string, offset, limit int) ([]*biz.SearchHit, int64, error) {
+	results, total := s.index.Search(query, offset, limit)
+	hits := make([]*biz.SearchHit, 0, len(results))
+	for _, r := range results {
+		hits = append(hits, &biz.SearchHit{
+			ID:                   r.ID,
+			ContentID:            r.ContentID,
+			Score:                r.Score,
+			TitleHighlight:       r.Title,
+			DescriptionHighlight: r.Description,
+		})
+	}
+	return hits, int64(total), nil
+}
+
+func (s *searchRepo) Reset(ctx context.Context) error {
+	s.changeMu.Lock()
+	defer s.changeMu.Unlock()
+	s.index.Reset()
+	s.pending = make(map[int64]*search.Document)
+	s.reset = true
+	return nil
+}
+
+func (s *searchRepo) Save(ctx context.Context) error {
+	if s.path == "" {
+		return nil
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.changeMu.Lock()
+	pending, reset := s.pending, s.reset
+	if len(pending) == 0 && !reset {
+		s.changeMu.Unlock()
+		return nil
+	}
+	// 写入期间的变更记入新的 pending, 留到下次写入
+	s.pending, s.reset = make(map[int64]*search.Document), false
+	s.changeMu.Unlock()
+	if err := s.index.Save(s.path); err != nil {
+		s.changeMu.Lock()
+		for id, doc := range s.pending {
+			pending[id] = doc
+		}
+		s.pending, s.reset = pending, s.reset || reset
+		s.changeMu.Unlock()
+		return err
+	}
+	if info, err := os.Stat(s.path); err == nil {
+		s.savedAt = info.ModTime()
+	}
+	return nil
+}
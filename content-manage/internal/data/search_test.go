@@ -0,0 +1,106 @@
+package data
+
+import (
+	"content_manage/internal/biz"
+	"content_manage/internal/conf"
+	"context"
+	"io"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// newTestSearchRepo 使用 path 作为快照的全文索引, 后台不定期写入, 由测试调用 flush
+func newTestSearchRepo(t *testing.T, path string) *searchRepo {
+	t.Helper()
+	c := &conf.Data{Search: &conf.Data_Search{Path: path, SaveInterval: durationpb.New(time.Hour)}}
+	repo, cleanup, err := NewSearchRepo(c, log.NewStdLogger(io.Discard))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(cleanup)
+	return repo.(*searchRepo)
+}
+
+func searchIDs(t *testing.T, repo *searchRepo, query string) map[int64]bool {
+	t.Helper()
+	hits, _, err := repo.Search(context.Background(), query, 0, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ids := make(map[int64]bool, len(hits))
+	for _, hit := range hits {
+		ids[hit.ID] = true
+	}
+	return ids
+}
+
+func TestSearchRepoSharedSnapshot(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "search.gob")
+	a := newTestSearchRepo(t, path)
+	b := newTestSearchRepo(t, path)
+
+	if err := a.Index(ctx, &biz.Content{ID: 1, Title: "golang"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := a.flush(ctx); err != nil {
+		t.Fatal(err)
+	}
+	// b 在 a 写入快照后的变更
+	if err := b.Index(ctx, &biz.Content{ID: 2, Title: "golang"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := b.Remove(ctx, 3); err != nil {
+		t.Fatal(err)
+	}
+
+	// 快照更新后重新加载, 不丢失本实例未写入的变更, 写回的快照包含两个实例的文档
+	time.Sleep(10 * time.Millisecond)
+	if err := b.flush(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if ids := searchIDs(t, b, "golang"); !ids[1] || !ids[2] {
+		t.Fatalf("b hits = %v, want 1 and 2", ids)
+	}
+	if err := a.flush(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if ids := searchIDs(t, a, "golang"); !ids[1] || !ids[2] {
+		t.Fatalf("a hits = %v after reload, want 1 and 2", ids)
+	}
+
+	// 重新启动的实例从快照加载全部文档
+	c := newTestSearchRepo(t, path)
+	if n := c.index.Len(); n != 2 {
+		t.Fatalf("reloaded %d documents, want 2", n)
+	}
+}
+
+func TestSearchRepoRemoveReplayed(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "search.gob")
+	a := newTestSearchRepo(t, path)
+	b := newTestSearchRepo(t, path)
+
+	if err := a.Index(ctx, &biz.Content{ID: 1, Title: "golang"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := a.flush(ctx); err != nil {
+		t.Fatal(err)
+	}
+	// b 删除了快照中的文档, 重新加载后重放删除
+	if err := b.Remove(ctx, 1); err != nil {
+		t.Fatal(err)
+	}
+	time.Sleep(10 * time.Millisecond)
+	if err := b.flush(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if ids := searchIDs(t, b, "golang"); len(ids) != 0 {
+		t.Fatalf("b hits = %v, want the removed document gone", ids)
+	}
+}
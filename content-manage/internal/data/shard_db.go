@@ -0,0 +1,313 @@
+package data
+
+import (
+	"content_manage/api/operate"
+	"content_manage/internal/biz"
+	"content_manage/internal/conf"
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/prometheus/client_golang/prometheus"
+	shard "github.com/zerokkcoder/content-shard"
+	"gorm.io/gorm"
+)
+
+const defaultHealthCheckInterval = 5 * time.Second
+
+var (
+	// shardDatabaseUp 分片库健康状态, 1 为可用
+	shardDatabaseUp = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "content_shard_database_up",
+		Help: "Whether the shard database is reachable",
+	}, []string{
+		"database",
+	})
+	// shardDatabaseErrors 分片库不可用导致的读写失败次数
+	shardDatabaseErrors = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "content_shard_database_errors_total",
+		Help: "Total number of detail reads and writes failed because the shard database is unavailable",
+	}, []string{
+		"database",
+	})
+)
+
+func init() {
+	prometheus.MustRegister(shardDatabaseUp, shardDatabaseErrors)
+}
+
+// shardDB 存放详情表的一个物理库
+type shardDB struct {
+	name string
+	db   *gorm.DB
+	// up 健康检查失败或查询遇到连接错误时置为 false, 不可用期间的请求直接失败, 不等待连接超时
+	up atomic.Bool
+}
+
+func (s *shardDB) setUp(up bool) {
+	s.up.Store(up)
+	if up {
+		shardDatabaseUp.WithLabelValues(s.name).Set(1)
+	} else {
+		shardDatabaseUp.WithLabelValues(s.name).Set(0)
+	}
+}
+
+// openShardDBs 打开配置的所有分片库; 启动时不检查连通性, 某个库不可用不影响服务启动
+func openShardDBs(c *conf.Data) (map[string]*shardDB, error) {
+	dbs := make(map[string]*shardDB, len(c.GetShardDatabases()))
+	for _, sc := range c.GetShardDatabases() {
+		if err := openShardDB(dbs, sc); err != nil {
+			// 关闭已打开的库
+			closeShardDBs(dbs)
+			return nil, err
+		}
+	}
+	return dbs, nil
+}
+
+func openShardDB(dbs map[string]*shardDB, sc *conf.Data_ShardDatabase) error {
+	if sc.GetName() == "" {
+		return errors.New("shard database name is required")
+	}
+	if _, ok := dbs[sc.GetName()]; ok {
+		return fmt.Errorf("duplicate shard database %s", sc.GetName())
+	}
+	gdb, err := openLazy(sc.GetSource())
+	if err != nil {
+		return fmt.Errorf("open shard database %s: %w", sc.GetName(), err)
+	}
+	if err := setPool(gdb, sc.GetName(), sc.GetMaxOpenConns(), sc.GetMaxIdleConns(), sc.GetConnMaxLifetime()); err != nil {
+		closeDB(gdb)
+		return err
+	}
+	s := &shardDB{name: sc.GetName(), db: gdb}
+	s.setUp(true)
+	dbs[s.name] = s
+	return nil
+}
+
+func closeShardDBs(dbs map[string]*shardDB) {
+	for _, s := range dbs {
+		closeDB(s.db)
+	}
+}
+
+// mapShardTables 按 Shard.databases 记录每张详情表所在的分片库, 未映射的表在主库中
+func mapShardTables(tables map[string]*shardDB, dbs map[string]*shardDB, router *shard.Router, names []string) error {
+	if len(names) == 0 {
+		return nil
+	}
+	if len(names) != router.Shards() {
+		return fmt.Errorf("shard databases %d != shards %d of topology %s", len(names), router.Shards(), router.Version())
+	}
+	for i, name := range names {
+		if name == "" {
+			continue
+		}
+		s, ok := dbs[name]
+		if !ok {
+			return fmt.Errorf("unknown shard database %s", name)
+		}
+		table := router.TableAt(i)
+		if prev, ok := tables[table]; ok && prev != s {
+			return fmt.Errorf("table %s is mapped to both %s and %s", table, prev.name, s.name)
+		}
+		tables[table] = s
+	}
+	return nil
+}
+
+// PrimaryDatabase 主库在 DetailTables 中的名称
+const PrimaryDatabase = "primary"
+
+// DetailTables 按配置返回每个物理库中的详情表, 包括重分片的目标布局, 主库为 PrimaryDatabase;
+// 不连接数据库, 供 cmd/migrate 生成各库的迁移语句
+func DetailTables(c *conf.Data) (map[string][]string, error) {
+	routers := make([]*shard.Router, 0, 2)
+	router, err := NewShardRouter(c)
+	if err != nil {
+		return nil, err
+	}
+	routers = append(routers, router)
+	names := [][]string{c.GetShard().GetDatabases()}
+	if target := c.GetReshard().GetTarget(); target != nil {
+		if router, err = newRouter(target); err != nil {
+			return nil, err
+		}
+		routers = append(routers, router)
+		names = append(names, target.GetDatabases())
+	}
+	dbs := make(map[string]*shardDB, len(c.GetShardDatabases()))
+	for _, sc := range c.GetShardDatabases() {
+		dbs[sc.GetName()] = &shardDB{name: sc.GetName()}
+	}
+	tableDBs := make(map[string]*shardDB)
+	for i, router := range routers {
+		if err := mapShardTables(tableDBs, dbs, router, names[i]); err != nil {
+			return nil, err
+		}
+	}
+	tables := make(map[string][]string)
+	seen := make(map[string]bool)
+	for _, router := range routers {
+		for _, table := range router.Tables() {
+			// 重分片的目标布局可以复用原有的表
+			if seen[table] {
+				continue
+			}
+			seen[table] = true
+			name := PrimaryDatabase
+			if s, ok := tableDBs[table]; ok {
+				name = s.name
+			}
+			tables[name] = append(tables[name], table)
+		}
+	}
+	return tables, nil
+}
+
+// watchShardDBs 定时检查各分片库, 直到 ctx 结束
+func watchShardDBs(ctx context.Context, dbs map[string]*shardDB, interval time.Duration, logger *log.Helper) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		for _, s := range dbs {
+			pingCtx, cancel := context.WithTimeout(ctx, interval)
+			err := s.db.WithContext(pingCtx).Exec("SELECT 1").Error
+			cancel()
+			if ctx.Err() != nil {
+				return
+			}
+			if up := err == nil; up != s.up.Load() {
+				if up {
+					logger.Infof("shard database %s is up", s.name)
+				} else {
+					logger.Errorf("shard database %s is down, error = %v", s.name, err)
+				}
+				s.setUp(up)
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+type contextShardTxKey struct{}
+
+// shardTxs 一次 ExecTx 中在各分片库上开启的事务
+type shardTxs struct {
+	mu  sync.Mutex
+	txs map[*shardDB]*gorm.DB
+	// before 被修改的详情在修改前的内容, seen 为已记录的 表/content_id
+	before []repairRow
+	seen   map[string]bool
+}
+
+// begin 返回 s 上的事务, 第一次访问时开启
+func (t *shardTxs) begin(ctx context.Context, s *shardDB) (*gorm.DB, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if tx, ok := t.txs[s]; ok {
+		return tx, nil
+	}
+	tx := s.db.WithContext(ctx).Begin()
+	if tx.Error != nil {
+		return nil, tx.Error
+	}
+	if t.txs == nil {
+		t.txs = make(map[*shardDB]*gorm.DB)
+	}
+	t.txs[s] = tx
+	return tx, nil
+}
+
+// modified 返回修改前的详情, 没有修改分片库时为空
+func (t *shardTxs) modified() []repairRow {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.before
+}
+
+// commit 依次提交各分片库的事务, 某个库提交失败时回滚其余未提交的事务
+func (t *shardTxs) commit() error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	for s, tx := range t.txs {
+		if err := tx.Commit().Error; err != nil {
+			delete(t.txs, s)
+			t.rollbackLocked()
+			return fmt.Errorf("commit shard database %s: %w", s.name, err)
+		}
+		delete(t.txs, s)
+	}
+	return nil
+}
+
+func (t *shardTxs) rollback() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.rollbackLocked()
+}
+
+func (t *shardTxs) rollbackLocked() {
+	for s, tx := range t.txs {
+		tx.Rollback()
+		delete(t.txs, s)
+	}
+}
+
+// detailDB 返回详情表所在库的查询, 已指定表名; ctx 处于事务中时加入该库上的事务
+func (d *Data) detailDB(ctx context.Context, table string) (*gorm.DB, error) {
+	s, ok := d.tableDBs[table]
+	if !ok {
+		return d.DB(ctx).Table(table), nil
+	}
+	if !s.up.Load() {
+		shardDatabaseErrors.WithLabelValues(s.name).Inc()
+		return nil, biz.ErrShardUnavailable.WithCause(fmt.Errorf("shard database %s is down", s.name))
+	}
+	if txs, ok := ctx.Value(contextShardTxKey{}).(*shardTxs); ok {
+		tx, err := txs.begin(ctx, s)
+		if err != nil {
+			return nil, d.shardError(table, err)
+		}
+		return tx.Table(table), nil
+	}
+	return s.db.WithContext(ctx).Table(table), nil
+}
+
+// readDetailDB 返回只读查询详情表使用的连接, 详情表与索引表同在主库时可以读从库
+func (d *Data) readDetailDB(ctx context.Context, table string) (*gorm.DB, error) {
+	if _, ok := d.tableDBs[table]; ok {
+		return d.detailDB(ctx, table)
+	}
+	return d.ReadDB(ctx).Table(table), nil
+}
+
+// shardError 把详情表上的连接错误转换为 biz.ErrShardUnavailable, 并将所在分片库标记为不可用
+func (d *Data) shardError(table string, err error) error {
+	if err == nil {
+		return nil
+	}
+	err = biz.MapError(err)
+	if !operate.IsShardUnavailable(err) {
+		return err
+	}
+	if s, ok := d.tableDBs[table]; ok {
+		shardDatabaseErrors.WithLabelValues(s.name).Inc()
+		// MapError 不把请求自身的超时或取消当作分片库不可用
+		if s.up.Load() {
+			d.log.Errorf("shard database %s is down, error = %v", s.name, err)
+			s.setUp(false)
+		}
+	}
+	return err
+}
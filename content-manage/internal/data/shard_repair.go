@@ -0,0 +1,247 @@
+package data
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/prometheus/client_golang/prometheus"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	// shardRepairInterval 扫描未处理补偿记录的间隔
+	shardRepairInterval = time.Minute
+	// shardRepairDelay 补偿记录创建后至少经过该时间才由后台任务处理, 期间事务可能仍在提交
+	shardRepairDelay = time.Minute
+	shardRepairBatch = 100
+)
+
+// shardRepairs 补偿记录的处理次数, result 为 committed、reverted 或 failed
+var shardRepairs = prometheus.NewCounterVec(prometheus.CounterOpts{
+	Name: "content_shard_repairs_total",
+	Help: "Total number of shard repair records resolved after cross-database transactions",
+}, []string{
+	"result",
+})
+
+func init() {
+	prometheus.MustRegister(shardRepairs)
+}
+
+// ShardRepair 分片库先于主库提交前写入主库的补偿记录, 保存分片库中被修改的详情在修改前的内容.
+// committed 在主库事务中置为 true, 主库回滚时保持 false, 据此把分片库的详情恢复到修改前
+type ShardRepair struct {
+	ID        int64     `gorm:"column:id;primaryKey"`
+	Snapshot  string    `gorm:"column:snapshot"`  // 修改前的详情, json
+	Committed bool      `gorm:"column:committed"` // 主库事务是否已提交
+	CreatedAt time.Time `gorm:"column:created_at"`
+}
+
+func (*ShardRepair) TableName() string {
+	return "cms_content.t_shard_repairs"
+}
+
+// repairRow 分片库中一行详情修改前的内容, Before 为 nil 表示修改前不存在
+type repairRow struct {
+	Database  string         `json:"database"`
+	Table     string         `json:"table"`
+	ContentID string         `json:"content_id"`
+	Before    *ContentDetail `json:"before"`
+}
+
+// writeDetailDB 返回写入详情表的连接; 详情表在分片库且处于事务中时, 先锁定并记录 contentIDs 修改前的详情
+func (d *Data) writeDetailDB(ctx context.Context, table string, contentIDs []string) (*gorm.DB, error) {
+	db, err := d.detailDB(ctx, table)
+	if err != nil {
+		return nil, err
+	}
+	s, sharded := d.tableDBs[table]
+	txs, inTx := ctx.Value(contextShardTxKey{}).(*shardTxs)
+	if !sharded || !inTx {
+		return db, nil
+	}
+	// 链式查询会复用条件, 锁定使用单独的查询
+	lock, err := d.detailDB(ctx, table)
+	if err != nil {
+		return nil, err
+	}
+	if err := txs.capture(lock, s, table, contentIDs); err != nil {
+		return nil, d.shardError(table, err)
+	}
+	return db, nil
+}
+
+// capture 锁定并记录第一次修改前的详情
+func (t *shardTxs) capture(db *gorm.DB, s *shardDB, table string, contentIDs []string) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.seen == nil {
+		t.seen = make(map[string]bool)
+	}
+	var missing []string
+	for _, contentID := range contentIDs {
+		if !t.seen[table+"/"+contentID] {
+			missing = append(missing, contentID)
+		}
+	}
+	if len(missing) == 0 {
+		return nil
+	}
+	var rows []*ContentDetail
+	if err := db.Unscoped().Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("content_id IN ?", missing).Find(&rows).Error; err != nil {
+		return err
+	}
+	before := make(map[string]*ContentDetail, len(rows))
+	for _, row := range rows {
+		before[row.ContentID] = row
+	}
+	for _, contentID := range missing {
+		t.seen[table+"/"+contentID] = true
+		t.before = append(t.before, repairRow{Database: s.name, Table: table, ContentID: contentID, Before: before[contentID]})
+	}
+	return nil
+}
+
+// prepareRepair 在分片库提交前写入补偿记录, 并在主库事务 tx 中标记为已提交;
+// 记录本身不在 tx 中写入, 主库回滚后仍然保留
+func (d *Data) prepareRepair(ctx context.Context, tx *gorm.DB, rows []repairRow) (*ShardRepair, error) {
+	snapshot, err := json.Marshal(rows)
+	if err != nil {
+		return nil, err
+	}
+	repair := &ShardRepair{Snapshot: string(snapshot)}
+	if err := d.db.WithContext(ctx).Create(repair).Error; err != nil {
+		return nil, err
+	}
+	if err := tx.Model(repair).Update("committed", true).Error; err != nil {
+		return nil, err
+	}
+	return repair, nil
+}
+
+// finishRepair 事务结束后处理补偿记录: 已提交时删除, 否则撤销分片库的修改; 失败时留给 watchShardRepairs 重试
+func (d *Data) finishRepair(ctx context.Context, repair *ShardRepair, committed bool) {
+	ctx = context.WithoutCancel(ctx)
+	if committed {
+		if err := d.db.WithContext(ctx).Where("id = ? AND committed = ?", repair.ID, true).
+			Delete(&ShardRepair{}).Error; err != nil {
+			d.log.WithContext(ctx).Errorf("delete shard repair %d error = %v", repair.ID, err)
+		}
+		return
+	}
+	// 主库提交可能已成功只是没有收到响应, 以记录中的 committed 为准
+	if _, err := d.resolveRepair(ctx, repair.ID); err != nil {
+		d.log.WithContext(ctx).Errorf("resolve shard repair %d error = %v", repair.ID, err)
+	}
+}
+
+// resolveRepair 处理一条补偿记录, 记录被进行中的事务锁定或已处理时返回 false
+func (d *Data) resolveRepair(ctx context.Context, id int64) (bool, error) {
+	var resolved bool
+	err := d.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var repair ShardRepair
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+			Where("id = ?", id).Take(&repair).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if !repair.Committed {
+			var rows []repairRow
+			if err := json.Unmarshal([]byte(repair.Snapshot), &rows); err != nil {
+				return err
+			}
+			if err := d.revertShards(ctx, tx, rows); err != nil {
+				shardRepairs.WithLabelValues("failed").Inc()
+				return err
+			}
+			shardRepairs.WithLabelValues("reverted").Inc()
+		} else {
+			shardRepairs.WithLabelValues("committed").Inc()
+		}
+		resolved = true
+		return tx.Delete(&repair).Error
+	})
+	return resolved, err
+}
+
+// revertShards 把与索引不一致的详情恢复到修改前. 在 tx 中锁定索引行, 期间同一内容的其他写入会等待;
+// 已与索引一致的详情说明之后有成功的写入, 不再恢复
+func (d *Data) revertShards(ctx context.Context, tx *gorm.DB, rows []repairRow) error {
+	for _, row := range rows {
+		s, ok := d.shardDBs[row.Database]
+		if !ok {
+			return fmt.Errorf("unknown shard database %s", row.Database)
+		}
+		var idx IdxContentDetail
+		err := tx.Unscoped().Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("content_id = ?", row.ContentID).Take(&idx).Error
+		missing := errors.Is(err, gorm.ErrRecordNotFound)
+		if err != nil && !missing {
+			return err
+		}
+		// 索引不存在且修改前有详情, 说明内容之后已被彻底删除
+		if missing && row.Before != nil {
+			continue
+		}
+		err = s.db.WithContext(ctx).Transaction(func(stx *gorm.DB) error {
+			var current ContentDetail
+			err := stx.Table(row.Table).Unscoped().Clauses(clause.Locking{Strength: "UPDATE"}).
+				Where("content_id = ?", row.ContentID).Take(&current).Error
+			exists := err == nil
+			if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+				return err
+			}
+			if !missing && exists && current.Version == idx.Version && current.DeletedAt.Valid == idx.DeletedAt.Valid {
+				return nil
+			}
+			if err := stx.Table(row.Table).Unscoped().
+				Where("content_id = ?", row.ContentID).Delete(&ContentDetail{}).Error; err != nil {
+				return err
+			}
+			if row.Before == nil {
+				return nil
+			}
+			return stx.Table(row.Table).Create(row.Before).Error
+		})
+		if err != nil {
+			return fmt.Errorf("revert %s content_id = %s: %w", row.Table, row.ContentID, err)
+		}
+	}
+	return nil
+}
+
+// watchShardRepairs 定时处理遗留的补偿记录, 如进程在分片库和主库提交之间退出, 直到 ctx 结束
+func (d *Data) watchShardRepairs(ctx context.Context, logger *log.Helper) {
+	ticker := time.NewTicker(shardRepairInterval)
+	defer ticker.Stop()
+	for {
+		var ids []int64
+		err := d.db.WithContext(ctx).Model(&ShardRepair{}).
+			Where("created_at < ?", time.Now().Add(-shardRepairDelay)).
+			Order("id").Limit(shardRepairBatch).Pluck("id", &ids).Error
+		if err != nil && ctx.Err() == nil {
+			logger.Errorf("list shard repairs error = %v", err)
+		}
+		for _, id := range ids {
+			if resolved, err := d.resolveRepair(ctx, id); err != nil {
+				logger.Errorf("resolve shard repair %d error = %v", id, err)
+			} else if resolved {
+				logger.Infof("shard repair %d resolved", id)
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,343 @@
+package data
+
+import (
+	"content_manage/api/operate"
+	"content_manage/internal/biz"
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// commitFailer 主库连接, fail 为 true 时事务提交失败并回滚, 模拟分片库已提交而主库提交失败
+type commitFailer struct {
+	*sql.DB
+	fail atomic.Bool
+}
+
+func (p *commitFailer) BeginTx(ctx context.Context, opts *sql.TxOptions) (gorm.ConnPool, error) {
+	tx, err := p.DB.BeginTx(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	return &failingTx{Tx: tx, fail: p.fail.Load()}, nil
+}
+
+type failingTx struct {
+	*sql.Tx
+	fail bool
+}
+
+func (tx *failingTx) Commit() error {
+	if tx.fail {
+		tx.Tx.Rollback()
+		return errors.New("primary commit failed")
+	}
+	return tx.Tx.Commit()
+}
+
+// newTestShardData 所有详情表都在分片库 shard_a 中, 主库的事务提交可以通过 commitFailer 失败
+func newTestShardData(t *testing.T) (*Data, *shardDB, *commitFailer) {
+	t.Helper()
+	d := newTestData(t, nil)
+	db, err := d.db.DB()
+	if err != nil {
+		t.Fatal(err)
+	}
+	pool := &commitFailer{DB: db}
+	if d.db, err = gorm.Open(sqlite.Dialector{Conn: pool}, &gorm.Config{Logger: logger.Discard}); err != nil {
+		t.Fatal(err)
+	}
+	s := &shardDB{name: "shard_a", db: newTestDB(t, d.router)}
+	s.setUp(true)
+	d.shardDBs[s.name] = s
+	if err := mapShardTables(d.tableDBs, d.shardDBs, d.router, []string{s.name, s.name, s.name, s.name}); err != nil {
+		t.Fatal(err)
+	}
+	return d, s, pool
+}
+
+// seedShardContent 直接写入主库的索引和分片库的详情, 版本号为 1
+func seedShardContent(t *testing.T, d *Data, s *shardDB, id int64, contentID string) {
+	t.Helper()
+	idx, detail := newContentRows(&biz.Content{ID: id, ContentID: contentID, Title: "before"})
+	if err := d.db.Create(idx).Error; err != nil {
+		t.Fatal(err)
+	}
+	if err := s.db.Table(d.router.Table(contentID)).Create(detail).Error; err != nil {
+		t.Fatal(err)
+	}
+}
+
+// shardDetail 分片库中的详情, 不存在时返回 nil
+func shardDetail(t *testing.T, d *Data, s *shardDB, contentID string) *ContentDetail {
+	t.Helper()
+	var detail ContentDetail
+	err := s.db.Table(d.router.Table(contentID)).Unscoped().Where("content_id = ?", contentID).Take(&detail).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &detail
+}
+
+func countRepairs(t *testing.T, d *Data) int64 {
+	t.Helper()
+	var n int64
+	if err := d.db.Model(&ShardRepair{}).Count(&n).Error; err != nil {
+		t.Fatal(err)
+	}
+	return n
+}
+
+// writeShards 在 ctx 的事务中修改 content-1 并新建 content-2 的详情; 只写分片库,
+// sqlite 同一时间只允许一个写事务, 主库事务写入后 prepareRepair 无法在事务外写补偿记录
+func writeShards(ctx context.Context, d *Data) error {
+	table := d.router.Table("content-1")
+	db, err := d.writeDetailDB(ctx, table, []string{"content-1"})
+	if err != nil {
+		return err
+	}
+	if err := db.Where("content_id = ?", "content-1").Updates(&ContentDetail{Title: "after", Version: 2}).Error; err != nil {
+		return err
+	}
+	table = d.router.Table("content-2")
+	if db, err = d.writeDetailDB(ctx, table, []string{"content-2"}); err != nil {
+		return err
+	}
+	_, detail := newContentRows(&biz.Content{ID: 2, ContentID: "content-2", Title: "new"})
+	return db.Create(detail).Error
+}
+
+func TestShardRepairPrimaryRollback(t *testing.T) {
+	d, s, pool := newTestShardData(t)
+	ctx := context.Background()
+	seedShardContent(t, d, s, 1, "content-1")
+
+	pool.fail.Store(true)
+	if err := d.ExecTx(ctx, func(ctx context.Context) error { return writeShards(ctx, d) }); err == nil {
+		t.Fatal("ExecTx() error = nil, want the primary commit error")
+	}
+
+	// 分片库已提交, 主库回滚后恢复到修改前
+	if detail := shardDetail(t, d, s, "content-1"); detail == nil || detail.Title != "before" || detail.Version != 1 {
+		t.Fatalf("content-1 detail = %+v, want the before-image", detail)
+	}
+	if detail := shardDetail(t, d, s, "content-2"); detail != nil {
+		t.Fatalf("content-2 detail = %+v, want it removed", detail)
+	}
+	if n := countRepairs(t, d); n != 0 {
+		t.Fatalf("%d shard repairs left, want 0", n)
+	}
+}
+
+func TestShardRepairCommitted(t *testing.T) {
+	d, s, _ := newTestShardData(t)
+	ctx := context.Background()
+	seedShardContent(t, d, s, 1, "content-1")
+
+	if err := d.ExecTx(ctx, func(ctx context.Context) error { return writeShards(ctx, d) }); err != nil {
+		t.Fatal(err)
+	}
+	if detail := shardDetail(t, d, s, "content-1"); detail == nil || detail.Title != "after" {
+		t.Fatalf("content-1 detail = %+v, want the committed update", detail)
+	}
+	if detail := shardDetail(t, d, s, "content-2"); detail == nil {
+		t.Fatal("content-2 detail missing after commit")
+	}
+	if n := countRepairs(t, d); n != 0 {
+		t.Fatalf("%d shard repairs left, want 0", n)
+	}
+}
+
+// crashBeforeFinish 按 ExecTx 的顺序写补偿记录、提交分片库, 主库按 commit 提交或回滚, 然后不调用 finishRepair,
+// 模拟进程在两次提交之间或提交之后退出
+func crashBeforeFinish(t *testing.T, d *Data, commit bool) {
+	t.Helper()
+	ctx := context.Background()
+	tx := d.db.Begin()
+	txs := &shardTxs{}
+	sctx := context.WithValue(context.WithValue(ctx, contextShardTxKey{}, txs), contextTxKey{}, tx)
+	if err := writeShards(sctx, d); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := d.prepareRepair(ctx, tx, txs.modified()); err != nil {
+		t.Fatal(err)
+	}
+	if err := txs.commit(); err != nil {
+		t.Fatal(err)
+	}
+	if commit {
+		if err := tx.Commit().Error; err != nil {
+			t.Fatal(err)
+		}
+	} else {
+		tx.Rollback()
+	}
+	// 补偿记录超过 shardRepairDelay 后才由后台任务处理
+	if err := d.db.Model(&ShardRepair{}).Where("1 = 1").
+		Update("created_at", time.Now().Add(-2*shardRepairDelay)).Error; err != nil {
+		t.Fatal(err)
+	}
+}
+
+// runRepairWatcher 运行 watchShardRepairs 直到补偿记录处理完
+func runRepairWatcher(t *testing.T, d *Data) {
+	t.Helper()
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		d.watchShardRepairs(ctx, log.NewHelper(log.NewStdLogger(io.Discard)))
+	}()
+	defer func() {
+		cancel()
+		<-done
+	}()
+	deadline := time.Now().Add(3 * time.Second)
+	for countRepairs(t, d) > 0 {
+		if time.Now().After(deadline) {
+			t.Fatal("shard repairs not resolved by the watcher")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestShardRepairWatcherReverts(t *testing.T) {
+	d, s, _ := newTestShardData(t)
+	seedShardContent(t, d, s, 1, "content-1")
+	crashBeforeFinish(t, d, false)
+	if detail := shardDetail(t, d, s, "content-1"); detail.Title != "after" {
+		t.Fatalf("content-1 title = %q before the watcher runs, want %q", detail.Title, "after")
+	}
+
+	runRepairWatcher(t, d)
+	if detail := shardDetail(t, d, s, "content-1"); detail == nil || detail.Title != "before" || detail.Version != 1 {
+		t.Fatalf("content-1 detail = %+v, want the before-image", detail)
+	}
+	if detail := shardDetail(t, d, s, "content-2"); detail != nil {
+		t.Fatalf("content-2 detail = %+v, want it removed", detail)
+	}
+}
+
+func TestShardRepairWatcherClearsCommitted(t *testing.T) {
+	d, s, _ := newTestShardData(t)
+	seedShardContent(t, d, s, 1, "content-1")
+	crashBeforeFinish(t, d, true)
+
+	// 主库已提交, 只删除补偿记录, 保留分片库的修改
+	runRepairWatcher(t, d)
+	if detail := shardDetail(t, d, s, "content-1"); detail == nil || detail.Title != "after" {
+		t.Fatalf("content-1 detail = %+v, want the committed update", detail)
+	}
+	if detail := shardDetail(t, d, s, "content-2"); detail == nil {
+		t.Fatal("content-2 detail removed after the primary committed")
+	}
+}
+
+func TestShardRepairWatcherDelay(t *testing.T) {
+	d, s, _ := newTestShardData(t)
+	seedShardContent(t, d, s, 1, "content-1")
+	crashBeforeFinish(t, d, false)
+	// 刚创建的补偿记录所在的事务可能仍在提交, 后台任务不处理
+	if err := d.db.Model(&ShardRepair{}).Where("1 = 1").Update("created_at", time.Now()).Error; err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		d.watchShardRepairs(ctx, log.NewHelper(log.NewStdLogger(io.Discard)))
+	}()
+	time.Sleep(100 * time.Millisecond)
+	cancel()
+	<-done
+	if n := countRepairs(t, d); n != 1 {
+		t.Fatalf("%d shard repairs left, want the recent one kept", n)
+	}
+}
+
+func TestShardDown(t *testing.T) {
+	d, s, _ := newTestShardData(t)
+	ctx := context.Background()
+	repo := &contentRepo{data: d, log: log.NewHelper(log.NewStdLogger(io.Discard))}
+	seedShardContent(t, d, s, 1, "content-1")
+	idx := &biz.ContentIndex{ID: 1, ContentID: "content-1"}
+	if _, err := repo.First(ctx, idx); err != nil {
+		t.Fatal(err)
+	}
+
+	// 查询遇到连接错误时标记分片库不可用
+	table := d.router.Table(idx.ContentID)
+	if err := d.shardError(table, driver.ErrBadConn); !operate.IsShardUnavailable(err) {
+		t.Fatalf("shardError() = %v, want SHARD_UNAVAILABLE", err)
+	}
+	if s.up.Load() {
+		t.Fatal("shard still up after a connection error")
+	}
+
+	// 不可用期间读写直接失败, 不访问分片库
+	if _, err := repo.First(ctx, idx); !operate.IsShardUnavailable(err) {
+		t.Fatalf("First() error = %v, want SHARD_UNAVAILABLE", err)
+	}
+	_, err := repo.Create(ctx, &biz.Content{ID: 2, ContentID: "content-2", Title: "t", VideoURL: "v", Author: "a"})
+	if !operate.IsShardUnavailable(err) {
+		t.Fatalf("Create() error = %v, want SHARD_UNAVAILABLE", err)
+	}
+	// 索引与详情在同一事务中, 详情写入失败时索引回滚
+	var n int64
+	if err := d.db.Model(&IdxContentDetail{}).Where("id = ?", 2).Count(&n).Error; err != nil || n != 0 {
+		t.Fatalf("index rows of the failed create = %d, %v, want 0", n, err)
+	}
+	if err := repo.UpdateFields(ctx, 1, &biz.Content{Title: "changed"}, []string{"title"}); !operate.IsShardUnavailable(err) {
+		t.Fatalf("UpdateFields() error = %v, want SHARD_UNAVAILABLE", err)
+	}
+	if err := repo.Delete(ctx, 1, 0); !operate.IsShardUnavailable(err) {
+		t.Fatalf("Delete() error = %v, want SHARD_UNAVAILABLE", err)
+	}
+
+	// 健康检查恢复后重新可读
+	runShardWatcher(t, d)
+	if !s.up.Load() {
+		t.Fatal("shard still down after a successful health check")
+	}
+	if _, err := repo.First(ctx, idx); err != nil {
+		t.Fatalf("First() after recovery error = %v", err)
+	}
+
+	// 健康检查失败时标记不可用
+	db, err := s.db.DB()
+	if err != nil {
+		t.Fatal(err)
+	}
+	db.Close()
+	runShardWatcher(t, d)
+	if s.up.Load() {
+		t.Fatal("shard still up after a failed health check")
+	}
+}
+
+// runShardWatcher 运行 watchShardDBs 的第一轮检查
+func runShardWatcher(t *testing.T, d *Data) {
+	t.Helper()
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		watchShardDBs(ctx, d.shardDBs, time.Hour, log.NewHelper(log.NewStdLogger(io.Discard)))
+	}()
+	time.Sleep(50 * time.Millisecond)
+	cancel()
+	<-done
+}
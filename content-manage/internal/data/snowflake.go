@@ -0,0 +1,271 @@
+package data
+
+import (
+	"content_manage/internal/biz"
+	"content_manage/internal/conf"
+	"context"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	clientv3 "go.etcd.io/etcd/client/v3"
+)
+
+// id 布局: 41 位毫秒时间戳 | 10 位 worker id | 12 位序列号
+const (
+	snowflakeEpoch = 1704067200000 // 2024-01-01 00:00:00 UTC
+	workerBits     = 10
+	sequenceBits   = 12
+	maxWorkerID    = 1<<workerBits - 1
+	maxSequence    = 1<<sequenceBits - 1
+)
+
+// NewEtcdClient 服务注册和 worker id 租约共用的 etcd 客户端
+func NewEtcdClient(c *conf.Data) (*clientv3.Client, func(), error) {
+	ec := c.GetEtcd()
+	endpoints := ec.GetEndpoints()
+	if len(endpoints) == 0 {
+		endpoints = []string{"127.0.0.1:2379"}
+	}
+	dialTimeout := 5 * time.Second
+	if ec.GetDialTimeout() != nil {
+		dialTimeout = ec.GetDialTimeout().AsDuration()
+	}
+	client, err := clientv3.New(clientv3.Config{
+		Endpoints:   endpoints,
+		DialTimeout: dialTimeout,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return client, func() { client.Close() }, nil
+}
+
+type snowflake struct {
+	client   *clientv3.Client
+	prefix   string
+	ttl      int64
+	maxDrift time.Duration
+	log      *log.Helper
+
+	mu sync.Mutex
+	// leased 持有 worker id 租约时才能发号, 租约丢失后 worker id 可能已被其他实例使用
+	leased bool
+	// deadline 最近一次续约成功后租约的到期时间, etcd 不可达时续约通道可能晚于租约到期才关闭, 到期后停止发号
+	deadline time.Time
+	workerID int64
+	leaseID  clientv3.LeaseID
+	// last 上一个 id 的毫秒时间戳
+	last     int64
+	sequence int64
+}
+
+// NewIDGenerator 从 etcd 租用 worker id 后发号, 租用失败时服务不启动
+func NewIDGenerator(c *conf.Data, client *clientv3.Client, logger log.Logger) (biz.IDGenerator, func(), error) {
+	sc := c.GetSnowflake()
+	s := &snowflake{
+		client:   client,
+		prefix:   "/content_manage/snowflake",
+		ttl:      10,
+		maxDrift: 100 * time.Millisecond,
+		log:      log.NewHelper(logger),
+	}
+	if sc.GetPrefix() != "" {
+		s.prefix = sc.GetPrefix()
+	}
+	if sc.GetLeaseTtl() != nil {
+		// etcd 租约按秒计算, 不足 1 秒无法续约; 非整数秒向上取整, 租约不短于配置
+		ttl := sc.GetLeaseTtl().AsDuration()
+		if ttl < time.Second {
+			return nil, nil, fmt.Errorf("snowflake lease_ttl %s is shorter than 1s", ttl)
+		}
+		s.ttl = int64((ttl + time.Second - 1) / time.Second)
+	}
+	if sc.GetMaxClockDrift() != nil {
+		s.maxDrift = sc.GetMaxClockDrift().AsDuration()
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	keepAlive, err := s.acquire(ctx)
+	if err != nil {
+		cancel()
+		return nil, nil, err
+	}
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		s.run(ctx, keepAlive)
+	}()
+	cleanup := func() {
+		cancel()
+		<-done
+		s.release()
+	}
+	return s, cleanup, nil
+}
+
+func (s *snowflake) NextID(ctx context.Context) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if !s.leased {
+		return 0, biz.ErrIDUnavailable
+	}
+	if !time.Now().Before(s.deadline) {
+		return 0, biz.ErrIDUnavailable.WithCause(fmt.Errorf("lease of worker id %d expired at %s", s.workerID, s.deadline.Format(time.RFC3339Nano)))
+	}
+	now := time.Now().UnixMilli()
+	if now < s.last {
+		// 时钟回拨, 小幅回拨时等待追上, 否则拒绝发号以免生成重复 id
+		drift := time.Duration(s.last-now) * time.Millisecond
+		if drift > s.maxDrift {
+			s.log.WithContext(ctx).Errorf("snowflake clock moved backwards by %s, worker id = %d", drift, s.workerID)
+			return 0, biz.ErrIDUnavailable.WithCause(fmt.Errorf("clock moved backwards by %s", drift))
+		}
+		sleep(ctx, drift)
+		if now = time.Now().UnixMilli(); now < s.last {
+			return 0, biz.ErrIDUnavailable.WithCause(fmt.Errorf("clock moved backwards by %s", drift))
+		}
+	}
+	if now == s.last {
+		s.sequence = (s.sequence + 1) & maxSequence
+		if s.sequence == 0 {
+			// 本毫秒的序列号已用完, 等到下一毫秒
+			for now <= s.last {
+				time.Sleep(100 * time.Microsecond)
+				now = time.Now().UnixMilli()
+			}
+		}
+	} else {
+		s.sequence = 0
+	}
+	s.last = now
+	return (now-snowflakeEpoch)<<(workerBits+sequenceBits) | s.workerID<<sequenceBits | s.sequence, nil
+}
+
+func (s *snowflake) leaseKey(workerID int64) string {
+	return fmt.Sprintf("%s/workers/%d", s.prefix, workerID)
+}
+
+// lastKey 不绑定租约, worker id 被复用时从上一个持有者的最后时间戳之后发号
+func (s *snowflake) lastKey(workerID int64) string {
+	return fmt.Sprintf("%s/last/%d", s.prefix, workerID)
+}
+
+// acquire 租用第一个空闲的 worker id
+func (s *snowflake) acquire(ctx context.Context) (<-chan *clientv3.LeaseKeepAliveResponse, error) {
+	// 租约从 etcd 收到请求时开始计时, 本地从发送前开始计算, 不会晚于 etcd 到期
+	granted := time.Now()
+	lease, err := s.client.Grant(ctx, s.ttl)
+	if err != nil {
+		return nil, fmt.Errorf("grant snowflake lease: %w", err)
+	}
+	for workerID := int64(0); workerID <= maxWorkerID; workerID++ {
+		key := s.leaseKey(workerID)
+		rsp, err := s.client.Txn(ctx).
+			If(clientv3.Compare(clientv3.CreateRevision(key), "=", 0)).
+			Then(clientv3.OpPut(key, "", clientv3.WithLease(lease.ID)), clientv3.OpGet(s.lastKey(workerID))).
+			Commit()
+		if err != nil {
+			s.client.Revoke(context.WithoutCancel(ctx), lease.ID)
+			return nil, fmt.Errorf("acquire snowflake worker id: %w", err)
+		}
+		if !rsp.Succeeded {
+			continue
+		}
+		var last int64
+		if kvs := rsp.Responses[1].GetResponseRange().GetKvs(); len(kvs) > 0 {
+			last, _ = strconv.ParseInt(string(kvs[0].Value), 10, 64)
+		}
+		keepAlive, err := s.client.KeepAlive(ctx, lease.ID)
+		if err != nil {
+			s.client.Revoke(context.WithoutCancel(ctx), lease.ID)
+			return nil, fmt.Errorf("keep snowflake lease alive: %w", err)
+		}
+		s.mu.Lock()
+		s.leased = true
+		s.deadline = granted.Add(time.Duration(lease.TTL) * time.Second)
+		s.workerID = workerID
+		s.leaseID = lease.ID
+		s.last = max(s.last, last)
+		s.sequence = 0
+		s.mu.Unlock()
+		s.log.Infof("snowflake worker id = %d", workerID)
+		return keepAlive, nil
+	}
+	s.client.Revoke(context.WithoutCancel(ctx), lease.ID)
+	return nil, fmt.Errorf("no free snowflake worker id under %s", s.prefix)
+}
+
+// run 定期保存最后时间戳, 租约丢失后停止发号并重新租用
+func (s *snowflake) run(ctx context.Context, keepAlive <-chan *clientv3.LeaseKeepAliveResponse) {
+	ticker := time.NewTicker(time.Duration(s.ttl) * time.Second / 3)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case rsp, ok := <-keepAlive:
+			if ok {
+				s.renewed(rsp)
+				continue
+			}
+			s.mu.Lock()
+			s.leased = false
+			workerID := s.workerID
+			s.mu.Unlock()
+			s.log.Errorf("snowflake lease of worker id %d lost", workerID)
+			for ctx.Err() == nil {
+				var err error
+				if keepAlive, err = s.acquire(ctx); err == nil {
+					break
+				}
+				s.log.Errorf("snowflake reacquire error = %v", err)
+				sleep(ctx, time.Second)
+			}
+		case <-ticker.C:
+			s.saveLast(ctx)
+		}
+	}
+}
+
+// renewed 续约成功后延长本地的租约期限
+func (s *snowflake) renewed(rsp *clientv3.LeaseKeepAliveResponse) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if rsp.ID == s.leaseID {
+		s.deadline = time.Now().Add(time.Duration(rsp.TTL) * time.Second)
+	}
+}
+
+// saveLast 仍持有租约时保存最后时间戳
+func (s *snowflake) saveLast(ctx context.Context) {
+	s.mu.Lock()
+	leased, workerID, leaseID, last := s.leased, s.workerID, s.leaseID, s.last
+	s.mu.Unlock()
+	if !leased || last == 0 {
+		return
+	}
+	_, err := s.client.Txn(ctx).
+		If(clientv3.Compare(clientv3.LeaseValue(s.leaseKey(workerID)), "=", leaseID)).
+		Then(clientv3.OpPut(s.lastKey(workerID), strconv.FormatInt(last, 10))).
+		Commit()
+	if err != nil && ctx.Err() == nil {
+		s.log.Errorf("snowflake save last timestamp error = %v", err)
+	}
+}
+
+// release 退出时保存最后时间戳并释放 worker id
+func (s *snowflake) release() {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+	s.saveLast(ctx)
+	s.mu.Lock()
+	s.leased = false
+	leaseID := s.leaseID
+	s.mu.Unlock()
+	if _, err := s.client.Revoke(ctx, leaseID); err != nil {
+		s.log.Errorf("snowflake revoke lease error = %v", err)
+	}
+}
@@ -0,0 +1,176 @@
+package data
+
+import (
+	"content_manage/internal/biz"
+	"content_manage/internal/conf"
+	"context"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	clientv3 "go.etcd.io/etcd/client/v3"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// newTestSnowflake 已持有 worker id 租约的发号器, 不连接 etcd
+func newTestSnowflake(workerID int64) *snowflake {
+	return &snowflake{
+		ttl:      3,
+		maxDrift: 100 * time.Millisecond,
+		log:      log.NewHelper(log.NewStdLogger(io.Discard)),
+		leased:   true,
+		deadline: time.Now().Add(3 * time.Second),
+		workerID: workerID,
+	}
+}
+
+func TestSnowflakeNextID(t *testing.T) {
+	s := newTestSnowflake(5)
+	ctx := context.Background()
+	var prev int64
+	// 超过一毫秒的序列号, 覆盖序列号用完等待下一毫秒
+	for i := 0; i < 3*maxSequence; i++ {
+		id, err := s.NextID(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if id <= prev {
+			t.Fatalf("NextID() = %d after %d, want increasing ids", id, prev)
+		}
+		if worker := id >> sequenceBits & maxWorkerID; worker != 5 {
+			t.Fatalf("NextID() worker id = %d, want 5", worker)
+		}
+		prev = id
+	}
+}
+
+func TestSnowflakeClockDrift(t *testing.T) {
+	ctx := context.Background()
+
+	// 小幅回拨时等待时钟追上上一个 id 的时间戳
+	s := newTestSnowflake(1)
+	s.last = time.Now().UnixMilli() + 30
+	last := s.last
+	id, err := s.NextID(ctx)
+	if err != nil {
+		t.Fatalf("NextID() with a 30ms drift error = %v", err)
+	}
+	if ts := id>>(workerBits+sequenceBits) + snowflakeEpoch; ts < last {
+		t.Fatalf("NextID() timestamp = %d, want at least %d", ts, last)
+	}
+
+	// 回拨超过 maxDrift 时拒绝发号, 不等待
+	s = newTestSnowflake(1)
+	s.last = time.Now().UnixMilli() + 1000
+	last = s.last
+	start := time.Now()
+	if _, err := s.NextID(ctx); !errors.Is(err, biz.ErrIDUnavailable) {
+		t.Fatalf("NextID() with a 1s drift error = %v, want ErrIDUnavailable", err)
+	}
+	if elapsed := time.Since(start); elapsed > 50*time.Millisecond {
+		t.Fatalf("NextID() waited %s before rejecting", elapsed)
+	}
+	if s.last != last {
+		t.Fatalf("NextID() moved last from %d to %d", last, s.last)
+	}
+}
+
+func TestSnowflakeLeaseLost(t *testing.T) {
+	// etcd 不可达, 重新租用一直失败
+	client, err := clientv3.New(clientv3.Config{Endpoints: []string{"127.0.0.1:1"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Close()
+	s := newTestSnowflake(1)
+	s.client = client
+	if _, err := s.NextID(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	keepAlive := make(chan *clientv3.LeaseKeepAliveResponse)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		s.run(ctx, keepAlive)
+	}()
+	// 续约通道关闭表示租约丢失, 之后 worker id 可能已被其他实例使用, 停止发号
+	close(keepAlive)
+	deadline := time.Now().Add(3 * time.Second)
+	for {
+		_, err := s.NextID(context.Background())
+		if errors.Is(err, biz.ErrIDUnavailable) {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("NextID() error = %v after the lease was lost, want ErrIDUnavailable", err)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	cancel()
+	select {
+	case <-done:
+	case <-time.After(3 * time.Second):
+		t.Fatal("run() did not stop after ctx was canceled")
+	}
+	if _, err := s.NextID(context.Background()); !errors.Is(err, biz.ErrIDUnavailable) {
+		t.Fatalf("NextID() error = %v without a lease, want ErrIDUnavailable", err)
+	}
+}
+
+func TestNewIDGeneratorLeaseTTL(t *testing.T) {
+	for _, ttl := range []time.Duration{0, 500 * time.Millisecond, -time.Second} {
+		c := &conf.Data{Snowflake: &conf.Data_Snowflake{LeaseTtl: durationpb.New(ttl)}}
+		// 配置不合法时不连接 etcd
+		if _, _, err := NewIDGenerator(c, nil, log.NewStdLogger(io.Discard)); err == nil {
+			t.Fatalf("NewIDGenerator(lease_ttl = %s) error = nil, want it rejected", ttl)
+		}
+	}
+}
+
+func TestSnowflakeLeaseExpired(t *testing.T) {
+	s := newTestSnowflake(1)
+	s.leaseID = 7
+	ctx, cancel := context.WithCancel(context.Background())
+	keepAlive := make(chan *clientv3.LeaseKeepAliveResponse)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		s.run(ctx, keepAlive)
+	}()
+	defer func() {
+		cancel()
+		<-done
+	}()
+
+	// etcd 失联时续约通道未关闭, 超过最近一次续约的租约期限后停止发号
+	s.mu.Lock()
+	s.deadline = time.Now().Add(-time.Millisecond)
+	s.mu.Unlock()
+	if _, err := s.NextID(context.Background()); !errors.Is(err, biz.ErrIDUnavailable) {
+		t.Fatalf("NextID() error = %v after the lease deadline, want ErrIDUnavailable", err)
+	}
+
+	// 其他租约的续约不延长期限
+	keepAlive <- &clientv3.LeaseKeepAliveResponse{ID: 8, TTL: 3}
+	keepAlive <- &clientv3.LeaseKeepAliveResponse{ID: 8, TTL: 3}
+	if _, err := s.NextID(context.Background()); !errors.Is(err, biz.ErrIDUnavailable) {
+		t.Fatalf("NextID() error = %v after renewing another lease, want ErrIDUnavailable", err)
+	}
+
+	// 续约成功后恢复发号; 第二次发送保证第一次已处理
+	keepAlive <- &clientv3.LeaseKeepAliveResponse{ID: 7, TTL: 3}
+	keepAlive <- &clientv3.LeaseKeepAliveResponse{ID: 7, TTL: 3}
+	if _, err := s.NextID(context.Background()); err != nil {
+		t.Fatalf("NextID() error = %v after the lease was renewed", err)
+	}
+	s.mu.Lock()
+	remaining := time.Until(s.deadline)
+	s.mu.Unlock()
+	if remaining <= 2*time.Second || remaining > 3*time.Second {
+		t.Fatalf("lease deadline in %s after renewing with ttl 3s", remaining)
+	}
+}
@@ -0,0 +1,143 @@
+package data
+
+import (
+	"content_manage/internal/biz"
+	"context"
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+func (c *contentRepo) FindDeleted(ctx context.Context, page, pageSize int32) ([]*biz.Content, int64, error) {
+	query := c.data.ReadDB(ctx).Unscoped().Model(&IdxContentDetail{}).Where("deleted_at IS NOT NULL")
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+	var indices []*IdxContentDetail
+	if err := query.Order("deleted_at DESC, id DESC").
+		Offset(int((page - 1) * pageSize)).
+		Limit(int(pageSize)).
+		Find(&indices).Error; err != nil {
+		c.log.WithContext(ctx).Errorf("contentRepo FindDeleted error = %v\n", err)
+		return nil, 0, err
+	}
+
+	contentIDs := make([]string, 0, len(indices))
+	for _, idx := range indices {
+		contentIDs = append(contentIDs, idx.ContentID)
+	}
+	details, unavailable, err := c.findDetails(ctx, contentIDs, true)
+	if err != nil {
+		return nil, 0, err
+	}
+	contents := make([]*biz.Content, 0, len(indices))
+	for _, idx := range indices {
+		detail, ok := details[idx.ContentID]
+		if unavailable[idx.ContentID] {
+			// 分片库不可用时回收站列表跳过该内容, 不影响其他分片
+			continue
+		}
+		if !ok {
+			c.log.WithContext(ctx).Warnf("contentRepo FindDeleted content_id = %s detail not found", idx.ContentID)
+			continue
+		}
+		contents = append(contents, toBizContent(&biz.ContentIndex{ID: idx.ID, ContentID: idx.ContentID}, detail))
+	}
+	return contents, total, nil
+}
+
+// deletedIndex 查询回收站中的索引, 不在回收站时返回 biz.ErrNotInTrash
+func (c *contentRepo) deletedIndex(ctx context.Context, id int64) (*IdxContentDetail, error) {
+	var idx IdxContentDetail
+	err := c.data.DB(ctx).Unscoped().Where("id = ? AND deleted_at IS NOT NULL", id).First(&idx).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, biz.ErrNotInTrash
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &idx, nil
+}
+
+func (c *contentRepo) Restore(ctx context.Context, id int64) error {
+	return c.data.ExecTx(ctx, func(ctx context.Context) error {
+		idx, err := c.deletedIndex(ctx, id)
+		if err != nil {
+			return err
+		}
+		db := c.data.DB(ctx)
+		if err := db.Unscoped().Model(idx).Update("deleted_at", nil).Error; err != nil {
+			c.log.WithContext(ctx).Errorf("contentRepo Restore IdxContentDetail error = %v\n", err)
+			return err
+		}
+		for _, table := range c.getContentDetailTables(idx.ContentID) {
+			query, err := c.data.writeDetailDB(ctx, table, []string{idx.ContentID})
+			if err != nil {
+				return err
+			}
+			if err := query.Where("content_id = ?", idx.ContentID).Update("deleted_at", nil).Error; err != nil {
+				c.log.WithContext(ctx).Errorf("contentRepo Restore ContentDetail error = %v\n", err)
+				return c.data.shardError(table, err)
+			}
+		}
+		return nil
+	})
+}
+
+func (c *contentRepo) Purge(ctx context.Context, id int64) error {
+	return c.data.ExecTx(ctx, func(ctx context.Context) error {
+		idx, err := c.deletedIndex(ctx, id)
+		if err != nil {
+			return err
+		}
+		return c.purge(ctx, idx)
+	})
+}
+
+func (c *contentRepo) PurgeDeletedBefore(ctx context.Context, before time.Time, limit int) (int, error) {
+	var indices []*IdxContentDetail
+	if err := c.data.DB(ctx).Unscoped().
+		Where("deleted_at < ?", before).
+		Order("id").
+		Limit(limit).
+		Find(&indices).Error; err != nil {
+		return 0, err
+	}
+	err := c.data.ExecTx(ctx, func(ctx context.Context) error {
+		for _, idx := range indices {
+			if err := c.purge(ctx, idx); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		c.log.WithContext(ctx).Errorf("contentRepo PurgeDeletedBefore error = %v\n", err)
+		return 0, err
+	}
+	return len(indices), nil
+}
+
+// purge 物理删除索引、详情和历史版本
+func (c *contentRepo) purge(ctx context.Context, idx *IdxContentDetail) error {
+	// 每条语句从 DB(ctx) 重新开始, 共用 Unscoped() 的返回值会把上一条语句的条件带到下一条
+	db := c.data.DB(ctx)
+	if err := db.Unscoped().Where("id = ?", idx.ID).Delete(&IdxContentDetail{}).Error; err != nil {
+		return err
+	}
+	if err := db.Where("content_id = ?", idx.ID).Delete(&ContentRevision{}).Error; err != nil {
+		return err
+	}
+	for _, table := range c.getContentDetailTables(idx.ContentID) {
+		query, err := c.data.writeDetailDB(ctx, table, []string{idx.ContentID})
+		if err != nil {
+			return err
+		}
+		if err := query.Unscoped().Where("content_id = ?", idx.ContentID).Delete(&ContentDetail{}).Error; err != nil {
+			return c.data.shardError(table, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,163 @@
+package data
+
+import (
+	"content_manage/internal/biz"
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+// trashIDs 回收站中的内容 id, 按删除时间倒序
+func trashIDs(t *testing.T, uc *biz.ContentUsecase) []int64 {
+	t.Helper()
+	contents, total, err := uc.ListDeletedContent(context.Background(), 1, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if int(total) != len(contents) {
+		t.Fatalf("ListDeletedContent() total = %d, listed %d", total, len(contents))
+	}
+	ids := make([]int64, 0, len(contents))
+	for _, c := range contents {
+		ids = append(ids, c.ID)
+	}
+	return ids
+}
+
+func TestTrashRestore(t *testing.T) {
+	uc, _ := newTestUsecase(t)
+	ctx := context.Background()
+	a := createUsecaseContent(t, uc, "alpha")
+	b := createUsecaseContent(t, uc, "bravo")
+
+	if err := uc.DeleteContent(ctx, a, 0); err != nil {
+		t.Fatal(err)
+	}
+	time.Sleep(10 * time.Millisecond)
+	if err := uc.DeleteContent(ctx, b, 0); err != nil {
+		t.Fatal(err)
+	}
+	// 删除后不可读取, 进入回收站
+	if _, err := uc.GetContent(ctx, a, ""); !errors.Is(err, biz.ErrContentNotFound) {
+		t.Fatalf("GetContent() of a deleted content error = %v, want ErrContentNotFound", err)
+	}
+	if ids := trashIDs(t, uc); !equalIDs(ids, []int64{b, a}) {
+		t.Fatalf("trash = %v, want [%d %d]", ids, b, a)
+	}
+
+	if err := uc.RestoreContent(ctx, a); err != nil {
+		t.Fatal(err)
+	}
+	restored, err := uc.GetContent(ctx, a, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if restored.Title != "alpha" {
+		t.Fatalf("restored title = %q, want %q", restored.Title, "alpha")
+	}
+	if ids := trashIDs(t, uc); !equalIDs(ids, []int64{b}) {
+		t.Fatalf("trash after restore = %v, want [%d]", ids, b)
+	}
+	// 恢复后重新加入全文索引
+	hits, _, err := uc.SearchContent(ctx, "alpha", 1, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(hits) != 1 || hits[0].Content.ID != a {
+		t.Fatalf("SearchContent() after restore = %+v, want %d", hits, a)
+	}
+	// 不在回收站中的内容不能恢复
+	if err := uc.RestoreContent(ctx, a); !errors.Is(err, biz.ErrNotInTrash) {
+		t.Fatalf("RestoreContent() twice error = %v, want ErrNotInTrash", err)
+	}
+}
+
+// purgedRows 内容在索引表、历史版本表和详情表中剩余的行数, 包括软删除的行
+func purgedRows(t *testing.T, d *Data, id int64, contentID string) (index, revisions, details int64) {
+	t.Helper()
+	if err := d.db.Unscoped().Model(&IdxContentDetail{}).Where("id = ?", id).Count(&index).Error; err != nil {
+		t.Fatal(err)
+	}
+	if err := d.db.Model(&ContentRevision{}).Where("content_id = ?", id).Count(&revisions).Error; err != nil {
+		t.Fatal(err)
+	}
+	if err := d.db.Table(d.router.Table(contentID)).Unscoped().Model(&ContentDetail{}).
+		Where("content_id = ?", contentID).Count(&details).Error; err != nil {
+		t.Fatal(err)
+	}
+	return index, revisions, details
+}
+
+func TestTrashPurge(t *testing.T) {
+	uc, d := newTestUsecase(t)
+	ctx := context.Background()
+	id := createUsecaseContent(t, uc, "alpha")
+	content, err := uc.GetContent(ctx, id, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := uc.UpdateContent(ctx, &biz.Content{ID: id, Title: "alpha 2"}, nil, "editor"); err != nil {
+		t.Fatal(err)
+	}
+
+	// 未删除的内容不能清除
+	if err := uc.PurgeContent(ctx, id); !errors.Is(err, biz.ErrNotInTrash) {
+		t.Fatalf("PurgeContent() of a live content error = %v, want ErrNotInTrash", err)
+	}
+	if err := uc.DeleteContent(ctx, id, 0); err != nil {
+		t.Fatal(err)
+	}
+	if index, revisions, details := purgedRows(t, d, id, content.ContentID); index != 1 || revisions == 0 || details != 1 {
+		t.Fatalf("before purge: index = %d, revisions = %d, details = %d", index, revisions, details)
+	}
+
+	if err := uc.PurgeContent(ctx, id); err != nil {
+		t.Fatal(err)
+	}
+	if index, revisions, details := purgedRows(t, d, id, content.ContentID); index != 0 || revisions != 0 || details != 0 {
+		t.Fatalf("after purge: index = %d, revisions = %d, details = %d, want all removed", index, revisions, details)
+	}
+	if ids := trashIDs(t, uc); len(ids) != 0 {
+		t.Fatalf("trash after purge = %v, want empty", ids)
+	}
+	if err := uc.RestoreContent(ctx, id); !errors.Is(err, biz.ErrNotInTrash) {
+		t.Fatalf("RestoreContent() after purge error = %v, want ErrNotInTrash", err)
+	}
+}
+
+func TestTrashRetention(t *testing.T) {
+	uc, d := newTestUsecase(t)
+	ctx := context.Background()
+	expired := createUsecaseContent(t, uc, "expired")
+	recent := createUsecaseContent(t, uc, "recent")
+	live := createUsecaseContent(t, uc, "live")
+	for _, id := range []int64{expired, recent} {
+		if err := uc.DeleteContent(ctx, id, 0); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// 超过保留时长的内容
+	if err := d.db.Unscoped().Model(&IdxContentDetail{}).Where("id = ?", expired).
+		Update("deleted_at", time.Now().Add(-2*time.Hour)).Error; err != nil {
+		t.Fatal(err)
+	}
+
+	purged, err := uc.PurgeExpiredContent(ctx, time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if purged != 1 {
+		t.Fatalf("PurgeExpiredContent() = %d, want 1", purged)
+	}
+	if ids := trashIDs(t, uc); !equalIDs(ids, []int64{recent}) {
+		t.Fatalf("trash after the retention job = %v, want [%d]", ids, recent)
+	}
+	if _, err := uc.GetContent(ctx, live, ""); err != nil {
+		t.Fatalf("GetContent() of a live content after the retention job error = %v", err)
+	}
+	// 再次执行没有可清除的内容
+	if purged, err := uc.PurgeExpiredContent(ctx, time.Hour); err != nil || purged != 0 {
+		t.Fatalf("PurgeExpiredContent() again = %d, %v, want 0", purged, err)
+	}
+}
@@ -0,0 +1,76 @@
+package search
+
+import (
+	"html"
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	highlightPre  = "<em>"
+	highlightPost = "</em>"
+	// 描述摘要的长度(字符数)
+	snippetRunes = 80
+)
+
+type span struct {
+	start, end int
+}
+
+// Highlight 用 <em></em> 包裹命中的词项, 其余文本做 HTML 转义.
+// maxRunes > 0 时只保留第一个命中附近 maxRunes 个字符的片段.
+func Highlight(text string, terms map[string]bool, maxRunes int) string {
+	// 合并重叠的命中区间, 二元组切分时相邻命中会重叠
+	var spans []span
+	for _, token := range Tokenize(text) {
+		if !terms[token.Term] {
+			continue
+		}
+		if n := len(spans); n > 0 && token.Start <= spans[n-1].end {
+			if token.End > spans[n-1].end {
+				spans[n-1].end = token.End
+			}
+			continue
+		}
+		spans = append(spans, span{token.Start, token.End})
+	}
+
+	from, to := 0, len(text)
+	if maxRunes > 0 && utf8.RuneCountInString(text) > maxRunes {
+		// 从第一个命中往前留出少量上下文
+		if len(spans) > 0 {
+			from = spans[0].start
+			for back := maxRunes / 4; back > 0 && from > 0; back-- {
+				_, size := utf8.DecodeLastRuneInString(text[:from])
+				from -= size
+			}
+		}
+		to = from
+		for n := 0; n < maxRunes && to < len(text); n++ {
+			_, size := utf8.DecodeRuneInString(text[to:])
+			to += size
+		}
+	}
+
+	var sb strings.Builder
+	if from > 0 {
+		sb.WriteString("...")
+	}
+	pos := from
+	for _, s := range spans {
+		if s.end <= from || s.start >= to {
+			continue
+		}
+		start, end := max(s.start, from), min(s.end, to)
+		sb.WriteString(html.EscapeString(text[pos:start]))
+		sb.WriteString(highlightPre)
+		sb.WriteString(html.EscapeString(text[start:end]))
+		sb.WriteString(highlightPost)
+		pos = end
+	}
+	sb.WriteString(html.EscapeString(text[pos:to]))
+	if to < len(text) {
+		sb.WriteString("...")
+	}
+	return sb.String()
+}
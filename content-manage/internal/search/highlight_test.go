@@ -0,0 +1,37 @@
+package search
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestHighlight(t *testing.T) {
+	long := strings.Repeat("前", 50) + "关键" + strings.Repeat("后", 50)
+	tests := []struct {
+		name     string
+		text     string
+		query    string
+		maxRunes int
+		want     string
+	}{
+		{"no match", "hello world", "golang", 0, "hello world"},
+		{"latin word", "Learn Go today", "go", 0, "Learn <em>Go</em> today"},
+		{"overlapping bigrams merged", "学习内容管理系统", "内容管理", 0, "学习<em>内容管理</em>系统"},
+		{"several matches", "go and Go", "go", 0, "<em>go</em> and <em>Go</em>"},
+		{"html escaped", "<b>go</b> & more", "go", 0, "&lt;b&gt;<em>go</em>&lt;/b&gt; &amp; more"},
+		{"snippet around the first match", long, "关键", 10,
+			"..." + strings.Repeat("前", 2) + "<em>关键</em>" + strings.Repeat("后", 6) + "..."},
+		{"short text not cut", "go", "go", 10, "<em>go</em>"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			terms := make(map[string]bool)
+			for _, term := range Terms(tt.query) {
+				terms[term] = true
+			}
+			if got := Highlight(tt.text, terms, tt.maxRunes); got != tt.want {
+				t.Fatalf("Highlight() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
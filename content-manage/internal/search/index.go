@@ -0,0 +1,286 @@
+package search
+
+import (
+	"encoding/gob"
+	"math"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+)
+
+const (
+	// BM25 参数
+	k1 = 1.2
+	b  = 0.75
+)
+
+// Field 被索引的字段
+type Field int
+
+const (
+	FieldTitle Field = iota
+	FieldDescription
+	numFields
+)
+
+// fieldBoost 标题命中的权重高于描述
+var fieldBoost = [numFields]float64{2, 1}
+
+// Document 被索引的内容
+type Document struct {
+	ID          int64
+	ContentID   string
+	Title       string
+	Description string
+}
+
+func (d *Document) field(f Field) string {
+	if f == FieldTitle {
+		return d.Title
+	}
+	return d.Description
+}
+
+// Hit 搜索结果
+type Hit struct {
+	ID        int64
+	ContentID string
+	Score     float64
+	// 高亮后的标题和描述片段, 命中词用 <em></em> 包裹
+	Title       string
+	Description string
+}
+
+type fieldIndex struct {
+	postings map[string]map[int64]int // term -> doc -> 词频
+	lengths  map[int64]int            // doc -> 词项数
+	total    int                      // 所有文档的词项数之和
+}
+
+func newFieldIndex() *fieldIndex {
+	return &fieldIndex{
+		postings: make(map[string]map[int64]int),
+		lengths:  make(map[int64]int),
+	}
+}
+
+func (fi *fieldIndex) add(id int64, text string) {
+	tokens := Tokenize(text)
+	for _, token := range tokens {
+		docs, ok := fi.postings[token.Term]
+		if !ok {
+			docs = make(map[int64]int)
+			fi.postings[token.Term] = docs
+		}
+		docs[id]++
+	}
+	fi.lengths[id] = len(tokens)
+	fi.total += len(tokens)
+}
+
+func (fi *fieldIndex) remove(id int64, text string) {
+	for _, term := range Terms(text) {
+		docs := fi.postings[term]
+		delete(docs, id)
+		if len(docs) == 0 {
+			delete(fi.postings, term)
+		}
+	}
+	fi.total -= fi.lengths[id]
+	delete(fi.lengths, id)
+}
+
+// Index 倒排索引, 并发安全
+type Index struct {
+	mu     sync.RWMutex
+	docs   map[int64]*Document
+	fields [numFields]*fieldIndex
+}
+
+// NewIndex 创建空索引
+func NewIndex() *Index {
+	idx := &Index{}
+	idx.reset()
+	return idx
+}
+
+func (idx *Index) reset() {
+	idx.docs = make(map[int64]*Document)
+	for f := range idx.fields {
+		idx.fields[f] = newFieldIndex()
+	}
+}
+
+// Reset 清空索引
+func (idx *Index) Reset() {
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+	idx.reset()
+}
+
+// Len 返回文档数
+func (idx *Index) Len() int {
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+	return len(idx.docs)
+}
+
+// Get 返回已索引的文档
+func (idx *Index) Get(id int64) (Document, bool) {
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+	doc, ok := idx.docs[id]
+	if !ok {
+		return Document{}, false
+	}
+	return *doc, true
+}
+
+// Put 新增或替换文档
+func (idx *Index) Put(doc Document) {
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+	idx.remove(doc.ID)
+	for f, fi := range idx.fields {
+		fi.add(doc.ID, doc.field(Field(f)))
+	}
+	idx.docs[doc.ID] = &doc
+}
+
+// Remove 删除文档
+func (idx *Index) Remove(id int64) {
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+	idx.remove(id)
+}
+
+func (idx *Index) remove(id int64) {
+	doc, ok := idx.docs[id]
+	if !ok {
+		return
+	}
+	for f, fi := range idx.fields {
+		fi.remove(id, doc.field(Field(f)))
+	}
+	delete(idx.docs, id)
+}
+
+// Search 按 BM25 打分返回 [offset, offset+limit) 的结果和命中总数
+func (idx *Index) Search(query string, offset, limit int) ([]*Hit, int) {
+	terms := Terms(query)
+	if len(terms) == 0 {
+		return nil, 0
+	}
+
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+	n := float64(len(idx.docs))
+	scores := make(map[int64]float64)
+	for f, fi := range idx.fields {
+		if len(fi.lengths) == 0 {
+			continue
+		}
+		avgLen := float64(fi.total) / float64(len(fi.lengths))
+		if avgLen == 0 {
+			avgLen = 1
+		}
+		for _, term := range terms {
+			docs := fi.postings[term]
+			if len(docs) == 0 {
+				continue
+			}
+			df := float64(len(docs))
+			idf := math.Log(1 + (n-df+0.5)/(df+0.5))
+			for id, tf := range docs {
+				norm := k1 * (1 - b + b*float64(fi.lengths[id])/avgLen)
+				scores[id] += fieldBoost[f] * idf * float64(tf) * (k1 + 1) / (float64(tf) + norm)
+			}
+		}
+	}
+
+	ids := make([]int64, 0, len(scores))
+	for id := range scores {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool {
+		if scores[ids[i]] != scores[ids[j]] {
+			return scores[ids[i]] > scores[ids[j]]
+		}
+		return ids[i] > ids[j]
+	})
+	total := len(ids)
+	if offset >= total {
+		return nil, total
+	}
+	ids = ids[offset:]
+	if len(ids) > limit {
+		ids = ids[:limit]
+	}
+
+	matched := make(map[string]bool, len(terms))
+	for _, term := range terms {
+		matched[term] = true
+	}
+	hits := make([]*Hit, 0, len(ids))
+	for _, id := range ids {
+		doc := idx.docs[id]
+		hits = append(hits, &Hit{
+			ID:          doc.ID,
+			ContentID:   doc.ContentID,
+			Score:       scores[id],
+			Title:       Highlight(doc.Title, matched, 0),
+			Description: Highlight(doc.Description, matched, snippetRunes),
+		})
+	}
+	return hits, total
+}
+
+// Save 将文档写入快照文件, 倒排表在加载时重建
+func (idx *Index) Save(path string) error {
+	idx.mu.RLock()
+	docs := make([]Document, 0, len(idx.docs))
+	for _, doc := range idx.docs {
+		docs = append(docs, *doc)
+	}
+	idx.mu.RUnlock()
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	// 先写临时文件再重命名, 避免写入中途失败损坏快照
+	tmp := path + ".tmp"
+	f, err := os.Create(tmp)
+	if err != nil {
+		return err
+	}
+	if err := gob.NewEncoder(f).Encode(docs); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
+
+// Load 从快照文件加载, 文件不存在时保持空索引
+func (idx *Index) Load(path string) error {
+	f, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	var docs []Document
+	if err := gob.NewDecoder(f).Decode(&docs); err != nil {
+		return err
+	}
+	idx.Reset()
+	for _, doc := range docs {
+		idx.Put(doc)
+	}
+	return nil
+}
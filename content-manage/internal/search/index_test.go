@@ -0,0 +1,143 @@
+package search
+
+import (
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func newTestIndex(docs ...Document) *Index {
+	idx := NewIndex()
+	for _, doc := range docs {
+		idx.Put(doc)
+	}
+	return idx
+}
+
+func hitIDs(hits []*Hit) []int64 {
+	ids := make([]int64, 0, len(hits))
+	for _, hit := range hits {
+		ids = append(ids, hit.ID)
+	}
+	return ids
+}
+
+func TestSearchRanking(t *testing.T) {
+	idx := newTestIndex(
+		Document{ID: 1, Title: "cooking basics", Description: "golang is mentioned once here"},
+		Document{ID: 2, Title: "golang tutorial", Description: "learn the language"},
+		Document{ID: 3, Title: "golang golang", Description: "golang everywhere"},
+		Document{ID: 4, Title: "unrelated", Description: "nothing to see"},
+		Document{ID: 5, Title: "golang concurrency patterns in depth for experienced engineers", Description: ""},
+	)
+	tests := []struct {
+		name  string
+		query string
+		want  []int64
+	}{
+		// 词频高的排前, 标题命中高于描述命中, 标题越长得分越低
+		{"term frequency and field boost", "golang", []int64{3, 2, 5, 1}},
+		{"any term matches", "cooking tutorial", []int64{2, 1}},
+		{"no match", "rust", []int64{}},
+		{"empty query", " , ", []int64{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hits, total := idx.Search(tt.query, 0, 10)
+			if got := hitIDs(hits); !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("Search(%q) = %v, want %v", tt.query, got, tt.want)
+			}
+			if total != len(tt.want) {
+				t.Fatalf("Search(%q) total = %d, want %d", tt.query, total, len(tt.want))
+			}
+		})
+	}
+}
+
+func TestSearchRankingIDF(t *testing.T) {
+	// 罕见词的权重高于常见词
+	idx := newTestIndex(
+		Document{ID: 1, Title: "video common"},
+		Document{ID: 2, Title: "video rare"},
+		Document{ID: 3, Title: "video common"},
+	)
+	hits, _ := idx.Search("common rare", 0, 10)
+	if got := hitIDs(hits); !reflect.DeepEqual(got, []int64{2, 3, 1}) {
+		t.Fatalf("Search() = %v, want [2 3 1]", got)
+	}
+}
+
+func TestSearchPaging(t *testing.T) {
+	idx := newTestIndex(
+		Document{ID: 1, Title: "go"},
+		Document{ID: 2, Title: "go"},
+		Document{ID: 3, Title: "go"},
+	)
+	// 同分时 id 大的在前
+	hits, total := idx.Search("go", 1, 1)
+	if got := hitIDs(hits); total != 3 || !reflect.DeepEqual(got, []int64{2}) {
+		t.Fatalf("Search() page = %v, total %d, want [2] of 3", got, total)
+	}
+	if hits, total := idx.Search("go", 5, 1); len(hits) != 0 || total != 3 {
+		t.Fatalf("Search() past the end = %d hits, total %d, want none of 3", len(hits), total)
+	}
+}
+
+func TestPutRemove(t *testing.T) {
+	idx := newTestIndex(Document{ID: 1, Title: "old title"})
+	idx.Put(Document{ID: 1, Title: "new title"})
+	if hits, _ := idx.Search("old", 0, 10); len(hits) != 0 {
+		t.Fatal("replaced title still matches")
+	}
+	if hits, _ := idx.Search("new", 0, 10); len(hits) != 1 {
+		t.Fatal("new title does not match")
+	}
+	idx.Remove(1)
+	if hits, _ := idx.Search("title", 0, 10); len(hits) != 0 || idx.Len() != 0 {
+		t.Fatal("removed document still indexed")
+	}
+	for _, fi := range idx.fields {
+		if len(fi.postings) != 0 || fi.total != 0 {
+			t.Fatalf("field index not empty after remove: %+v", fi)
+		}
+	}
+}
+
+func TestSaveLoad(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "index", "search.gob")
+	idx := newTestIndex(
+		Document{ID: 1, ContentID: "c1", Title: "内容管理", Description: "golang"},
+		Document{ID: 2, ContentID: "c2", Title: "视频", Description: "教程"},
+	)
+	if err := idx.Save(path); err != nil {
+		t.Fatal(err)
+	}
+
+	loaded := newTestIndex(Document{ID: 9, Title: "stale"})
+	if err := loaded.Load(path); err != nil {
+		t.Fatal(err)
+	}
+	if loaded.Len() != 2 {
+		t.Fatalf("Load() documents = %d, want 2", loaded.Len())
+	}
+	for _, id := range []int64{1, 2} {
+		want, _ := idx.Get(id)
+		if got, ok := loaded.Get(id); !ok || got != want {
+			t.Fatalf("Get(%d) = %+v, want %+v", id, got, want)
+		}
+	}
+	// 倒排表在加载时重建, 打分与保存前一致
+	for _, query := range []string{"管理", "golang", "视频教程"} {
+		want, _ := idx.Search(query, 0, 10)
+		got, _ := loaded.Search(query, 0, 10)
+		if !reflect.DeepEqual(got, want) {
+			t.Fatalf("Search(%q) after Load() = %+v, want %+v", query, got, want)
+		}
+	}
+
+	// 快照不存在时保持空索引
+	empty := NewIndex()
+	if err := empty.Load(filepath.Join(t.TempDir(), "missing.gob")); err != nil || empty.Len() != 0 {
+		t.Fatalf("Load() missing file = %d documents, %v, want an empty index", empty.Len(), err)
+	}
+}
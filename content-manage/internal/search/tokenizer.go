@@ -0,0 +1,91 @@
+// Package search 内容标题和描述的内嵌全文索引, 中文按二元组切分, BM25 打分.
+package search
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Token 切分出的词项, Start/End 为在原文中的字节偏移
+type Token struct {
+	Term  string
+	Start int
+	End   int
+}
+
+// isCJK 中日韩文字没有空格分词, 按二元组切分
+func isCJK(r rune) bool {
+	return unicode.Is(unicode.Han, r) ||
+		unicode.Is(unicode.Hiragana, r) ||
+		unicode.Is(unicode.Katakana, r) ||
+		unicode.Is(unicode.Hangul, r)
+}
+
+func isWord(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+// Tokenize 切分文本: 字母数字按单词切分并转小写, 中日韩文字按相邻两字切分, 单字成词时保留单字
+func Tokenize(text string) []Token {
+	var (
+		tokens []Token
+		i      int
+	)
+	for i < len(text) {
+		r, size := utf8.DecodeRuneInString(text[i:])
+		switch {
+		case isCJK(r):
+			// 收集连续的中日韩文字
+			var offsets []int
+			j := i
+			for j < len(text) {
+				r, size := utf8.DecodeRuneInString(text[j:])
+				if !isCJK(r) {
+					break
+				}
+				offsets = append(offsets, j)
+				j += size
+			}
+			offsets = append(offsets, j)
+			if len(offsets) == 2 {
+				tokens = append(tokens, Token{Term: text[i:j], Start: i, End: j})
+			}
+			for k := 0; k+2 < len(offsets); k++ {
+				tokens = append(tokens, Token{
+					Term:  text[offsets[k]:offsets[k+2]],
+					Start: offsets[k],
+					End:   offsets[k+2],
+				})
+			}
+			i = j
+		case isWord(r):
+			j := i
+			for j < len(text) {
+				r, size := utf8.DecodeRuneInString(text[j:])
+				if !isWord(r) || isCJK(r) {
+					break
+				}
+				j += size
+			}
+			tokens = append(tokens, Token{Term: strings.ToLower(text[i:j]), Start: i, End: j})
+			i = j
+		default:
+			i += size
+		}
+	}
+	return tokens
+}
+
+// Terms 返回去重后的词项
+func Terms(text string) []string {
+	seen := make(map[string]bool)
+	var terms []string
+	for _, token := range Tokenize(text) {
+		if !seen[token.Term] {
+			seen[token.Term] = true
+			terms = append(terms, token.Term)
+		}
+	}
+	return terms
+}
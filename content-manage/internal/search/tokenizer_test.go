@@ -0,0 +1,47 @@
+package search
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestTokenize(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want []Token
+	}{
+		{"empty", "", nil},
+		{"latin words", "Hello, World 2024", []Token{
+			{"hello", 0, 5}, {"world", 7, 12}, {"2024", 13, 17},
+		}},
+		{"single cjk rune", "猫", []Token{{"猫", 0, 3}}},
+		{"cjk bigrams", "内容管理", []Token{
+			{"内容", 0, 6}, {"容管", 3, 9}, {"管理", 6, 12},
+		}},
+		{"mixed latin and cjk", "Go语言入门v2", []Token{
+			{"go", 0, 2}, {"语言", 2, 8}, {"言入", 5, 11}, {"入门", 8, 14}, {"v2", 14, 16},
+		}},
+		{"cjk runs split by punctuation", "视频，教程", []Token{
+			{"视频", 0, 6}, {"教程", 9, 15},
+		}},
+		{"kana and hangul", "カナ한국", []Token{
+			{"カナ", 0, 6}, {"ナ한", 3, 9}, {"한국", 6, 12},
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Tokenize(tt.text); !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("Tokenize(%q) = %v, want %v", tt.text, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTerms(t *testing.T) {
+	got := Terms("Go go 语言语言")
+	want := []string{"go", "语言", "言语"}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("Terms() = %v, want %v", got, want)
+	}
+}
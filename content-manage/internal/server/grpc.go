@@ -15,7 +15,10 @@ func NewGRPCServer(c *conf.Server, app *service.AppService, logger log.Logger) *
 	var opts = []grpc.ServerOption{
 		grpc.Middleware(
 			recovery.Recovery(),
+			reason(),
+			validator(),
 		),
+		grpc.StreamInterceptor(streamReason(), streamValidator()),
 	}
 	if c.Grpc.Network != "" {
 		opts = append(opts, grpc.Network(c.Grpc.Network))
@@ -1,22 +1,49 @@
 package server
 
-// // NewHTTPServer new an HTTP server.
-// func NewHTTPServer(c *conf.Server, greeter *service.GreeterService, logger log.Logger) *http.Server {
-// 	var opts = []http.ServerOption{
-// 		http.Middleware(
-// 			recovery.Recovery(),
-// 		),
-// 	}
-// 	if c.Http.Network != "" {
-// 		opts = append(opts, http.Network(c.Http.Network))
-// 	}
-// 	if c.Http.Addr != "" {
-// 		opts = append(opts, http.Address(c.Http.Addr))
-// 	}
-// 	if c.Http.Timeout != nil {
-// 		opts = append(opts, http.Timeout(c.Http.Timeout.AsDuration()))
-// 	}
-// 	srv := http.NewServer(opts...)
-// 	v1.RegisterGreeterHTTPServer(srv, greeter)
-// 	return srv
-// }
+import (
+	"content_manage/api/operate"
+	"content_manage/internal/conf"
+	"content_manage/internal/service"
+	"encoding/json"
+	nethttp "net/http"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/middleware/recovery"
+	"github.com/go-kratos/kratos/v2/transport/http"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	shard "github.com/zerokkcoder/content-shard"
+)
+
+// NewHTTPServer new an HTTP server, serving the App API, the prometheus metrics at /metrics
+// and the shard topology version at /v1/shard/topology.
+func NewHTTPServer(c *conf.Server, app *service.AppService, router *shard.Router, logger log.Logger) *http.Server {
+	var opts = []http.ServerOption{
+		http.Middleware(
+			recovery.Recovery(),
+			reason(),
+			validator(),
+		),
+	}
+	if c.Http.Network != "" {
+		opts = append(opts, http.Network(c.Http.Network))
+	}
+	if c.Http.Addr != "" {
+		opts = append(opts, http.Address(c.Http.Addr))
+	}
+	if c.Http.Timeout != nil {
+		opts = append(opts, http.Timeout(c.Http.Timeout.AsDuration()))
+	}
+	srv := http.NewServer(opts...)
+	srv.Handle("/metrics", promhttp.Handler())
+	srv.HandleFunc("/v1/shard/topology", topologyHandler(router))
+	operate.RegisterAppHTTPServer(srv, app)
+	return srv
+}
+
+// topologyHandler 返回详情表分片的拓扑版本, 直接访问详情表的服务启动时据此校验本地路由
+func topologyHandler(router *shard.Router) nethttp.HandlerFunc {
+	return func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]string{"version": router.Version()})
+	}
+}
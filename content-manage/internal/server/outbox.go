@@ -0,0 +1,128 @@
+package server
+
+import (
+	"content_manage/internal/biz"
+	"content_manage/internal/conf"
+	"context"
+	"sync"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/transport"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	// 待投递消息默认轮询间隔、每批数量和最大重试间隔
+	defaultOutboxPollInterval = time.Second
+	defaultOutboxBatchSize    = 100
+	defaultOutboxMaxBackoff   = 5 * time.Minute
+)
+
+var (
+	// outboxBacklog 未投递的消息数量, 包括等待重试的消息
+	outboxBacklog = prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "content_outbox_backlog",
+		Help: "Number of outbox messages not yet delivered",
+	})
+	// outboxDeliveries 投递次数, result 为 delivered 或 failed
+	outboxDeliveries = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "content_outbox_deliveries_total",
+		Help: "Total number of outbox delivery attempts",
+	}, []string{
+		"result",
+	})
+)
+
+func init() {
+	prometheus.MustRegister(outboxBacklog, outboxDeliveries)
+}
+
+var _ transport.Server = (*OutboxServer)(nil)
+
+// OutboxServer relays outbox messages, such as content-flow triggers, after their transactions commit.
+type OutboxServer struct {
+	uc         *biz.OutboxUsecase
+	interval   time.Duration
+	batchSize  int
+	maxBackoff time.Duration
+	log        *log.Helper
+
+	once sync.Once
+	stop chan struct{}
+}
+
+// NewOutboxServer new an outbox relay.
+func NewOutboxServer(c *conf.Data, uc *biz.OutboxUsecase, logger log.Logger) *OutboxServer {
+	interval := defaultOutboxPollInterval
+	if d := c.GetOutbox().GetPollInterval(); d != nil && d.AsDuration() > 0 {
+		interval = d.AsDuration()
+	}
+	batchSize := defaultOutboxBatchSize
+	if n := c.GetOutbox().GetBatchSize(); n > 0 {
+		batchSize = int(n)
+	}
+	maxBackoff := defaultOutboxMaxBackoff
+	if d := c.GetOutbox().GetMaxBackoff(); d != nil && d.AsDuration() > 0 {
+		maxBackoff = d.AsDuration()
+	}
+	return &OutboxServer{
+		uc:         uc,
+		interval:   interval,
+		batchSize:  batchSize,
+		maxBackoff: maxBackoff,
+		log:        log.NewHelper(logger),
+		stop:       make(chan struct{}),
+	}
+}
+
+func (s *OutboxServer) Start(ctx context.Context) error {
+	s.log.Infof("[Outbox] relaying messages every %s", s.interval)
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+	for {
+		full := s.relay(ctx) == s.batchSize
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-s.stop:
+			return nil
+		default:
+		}
+		// 一批投递满时不等待, 继续投递积压的消息
+		if full {
+			continue
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-s.stop:
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *OutboxServer) Stop(ctx context.Context) error {
+	s.once.Do(func() { close(s.stop) })
+	return nil
+}
+
+// relay 投递一批消息, 返回尝试投递的数量
+func (s *OutboxServer) relay(ctx context.Context) int {
+	delivered, failed, err := s.uc.Relay(ctx, s.batchSize, s.maxBackoff)
+	outboxDeliveries.WithLabelValues("delivered").Add(float64(delivered))
+	outboxDeliveries.WithLabelValues("failed").Add(float64(failed))
+	if err != nil {
+		s.log.Errorf("[Outbox] relay error = %v", err)
+	}
+	if backlog, err := s.uc.Backlog(ctx); err == nil {
+		outboxBacklog.Set(float64(backlog))
+	} else {
+		s.log.Errorf("[Outbox] backlog error = %v", err)
+	}
+	if err != nil {
+		return 0
+	}
+	return delivered + failed
+}
@@ -0,0 +1,25 @@
+package server
+
+import (
+	"content_manage/internal/biz"
+	"context"
+
+	"github.com/go-kratos/kratos/v2/middleware"
+	"google.golang.org/grpc"
+)
+
+// reason 业务层返回的错误统一映射为 ErrorReason, 客户端不再收到 reason 为空的 UNKNOWN 错误
+func reason() middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			reply, err := handler(ctx, req)
+			return reply, biz.MapError(err)
+		}
+	}
+}
+
+func streamReason() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return biz.MapError(handler(srv, ss))
+	}
+}
@@ -0,0 +1,78 @@
+package server
+
+import (
+	"content_manage/internal/biz"
+	"content_manage/internal/conf"
+	"context"
+	"sync"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/transport"
+)
+
+// 回收站清理默认间隔
+const defaultPurgeInterval = time.Hour
+
+var _ transport.Server = (*TrashServer)(nil)
+
+// TrashServer periodically purges Contents that have stayed in the trash longer than the retention.
+type TrashServer struct {
+	uc        *biz.ContentUsecase
+	retention time.Duration
+	interval  time.Duration
+	log       *log.Helper
+
+	once sync.Once
+	stop chan struct{}
+}
+
+// NewTrashServer new a trash retention job, it does nothing when data.trash.retention is not set.
+func NewTrashServer(c *conf.Data, uc *biz.ContentUsecase, logger log.Logger) *TrashServer {
+	interval := defaultPurgeInterval
+	if d := c.GetTrash().GetPurgeInterval(); d != nil && d.AsDuration() > 0 {
+		interval = d.AsDuration()
+	}
+	return &TrashServer{
+		uc:        uc,
+		retention: c.GetTrash().GetRetention().AsDuration(),
+		interval:  interval,
+		log:       log.NewHelper(logger),
+		stop:      make(chan struct{}),
+	}
+}
+
+func (s *TrashServer) Start(ctx context.Context) error {
+	if s.retention <= 0 {
+		s.log.Info("[Trash] retention is not set, purging is disabled")
+		return nil
+	}
+	s.log.Infof("[Trash] purging contents deleted more than %s ago every %s", s.retention, s.interval)
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+	for {
+		s.purge(ctx)
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-s.stop:
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *TrashServer) Stop(ctx context.Context) error {
+	s.once.Do(func() { close(s.stop) })
+	return nil
+}
+
+func (s *TrashServer) purge(ctx context.Context) {
+	purged, err := s.uc.PurgeExpiredContent(ctx, s.retention)
+	if err != nil {
+		s.log.Errorf("[Trash] purge error = %v", err)
+	}
+	if purged > 0 {
+		s.log.Infof("[Trash] purged %d contents", purged)
+	}
+}
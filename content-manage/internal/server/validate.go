@@ -0,0 +1,111 @@
+package server
+
+import (
+	"content_manage/api/operate"
+	"context"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/go-kratos/kratos/v2/middleware"
+	"google.golang.org/grpc"
+)
+
+// validator 按 proto 中的 validate 规则校验请求, 不合法的请求不会进入业务逻辑
+func validator() middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			if err := validate(req); err != nil {
+				return nil, err
+			}
+			return handler(ctx, req)
+		}
+	}
+}
+
+// streamValidator 流式接口不经过 kratos 中间件, 在接收请求时校验
+func streamValidator() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return handler(srv, &validatingStream{ServerStream: ss})
+	}
+}
+
+type validatingStream struct {
+	grpc.ServerStream
+}
+
+func (s *validatingStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	return validate(m)
+}
+
+// validate 校验失败返回 INVALID_CONTENT, metadata 中为字段路径到原因的映射, 如 content.video_url
+func validate(req interface{}) error {
+	v, ok := req.(interface{ ValidateAll() error })
+	if !ok {
+		return nil
+	}
+	err := v.ValidateAll()
+	if err == nil {
+		return nil
+	}
+	fields := make(map[string]string)
+	collectFields(err, "", fields)
+	paths := make([]string, 0, len(fields))
+	for path := range fields {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	msgs := make([]string, 0, len(paths))
+	for _, path := range paths {
+		msgs = append(msgs, path+": "+fields[path])
+	}
+	return operate.ErrorInvalidContent("%s", strings.Join(msgs, "; ")).WithMetadata(fields).WithCause(err)
+}
+
+type fieldError interface {
+	Field() string
+	Reason() string
+	Cause() error
+}
+
+// collectFields 展开嵌套消息和 repeated 字段的校验错误
+func collectFields(err error, prefix string, fields map[string]string) {
+	switch e := err.(type) {
+	case interface{ AllErrors() []error }:
+		for _, err := range e.AllErrors() {
+			collectFields(err, prefix, fields)
+		}
+	case fieldError:
+		path := prefix + snakeCase(e.Field())
+		cause := e.Cause()
+		if _, ok := cause.(interface{ AllErrors() []error }); ok {
+			collectFields(cause, path+".", fields)
+			return
+		}
+		if _, ok := cause.(fieldError); ok {
+			collectFields(cause, path+".", fields)
+			return
+		}
+		fields[path] = e.Reason()
+	default:
+		fields[strings.TrimSuffix(prefix, ".")] = err.Error()
+	}
+}
+
+// snakeCase 将生成代码中的 Go 字段名转换为 proto 字段名, 如 ApprovalStatus[0] 转为 approval_status[0]
+func snakeCase(field string) string {
+	var b strings.Builder
+	for i, r := range field {
+		if unicode.IsUpper(r) {
+			if i > 0 && field[i-1] != '[' {
+				b.WriteByte('_')
+			}
+			r = unicode.ToLower(r)
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
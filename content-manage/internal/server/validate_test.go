@@ -0,0 +1,111 @@
+package server
+
+import (
+	"content_manage/api/operate"
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	kerrors "github.com/go-kratos/kratos/v2/errors"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+func TestSnakeCase(t *testing.T) {
+	tests := map[string]string{
+		"Title":             "title",
+		"VideoUrl":          "video_url",
+		"ApprovalStatus[0]": "approval_status[0]",
+		"Contents[1]":       "contents[1]",
+		"page_size":         "page_size",
+	}
+	for field, want := range tests {
+		if got := snakeCase(field); got != want {
+			t.Fatalf("snakeCase(%q) = %q, want %q", field, got, want)
+		}
+	}
+}
+
+func TestValidator(t *testing.T) {
+	tests := []struct {
+		name string
+		req  interface{}
+		// fields 为空时请求合法
+		fields map[string]string
+	}{
+		{
+			name: "valid",
+			req:  &operate.CreateContentReq{Content: &operate.Content{Title: "title", VideoUrl: "https://example.com/v.mp4"}},
+		},
+		{
+			name:   "missing content",
+			req:    &operate.CreateContentReq{},
+			fields: map[string]string{"content": "value is required"},
+		},
+		{
+			name: "nested fields",
+			req: &operate.CreateContentReq{Content: &operate.Content{
+				Title:          strings.Repeat("x", 256),
+				VideoUrl:       "not a url",
+				Duration:       durationpb.New(-1),
+				FileSize:       -1,
+				ApprovalStatus: 4,
+			}},
+			fields: map[string]string{
+				"content.title":           "value length must be at most 255 runes",
+				"content.video_url":       "value must be absolute",
+				"content.duration":        "value must be greater than or equal to 0s",
+				"content.file_size":       "value must be greater than or equal to 0",
+				"content.approval_status": "value must be inside range [0, 3]",
+			},
+		},
+		{
+			name:   "repeated messages",
+			req:    &operate.BatchCreateContentReq{Contents: []*operate.Content{{}, {Quality: 9}}},
+			fields: map[string]string{"contents[1].quality": "value must be inside range [0, 3]"},
+		},
+		{
+			name:   "repeated items",
+			req:    &operate.FindContentReq{ApprovalStatus: []int32{1, 5}, PageSize: 101},
+			fields: map[string]string{"approval_status[1]": "value must be inside range [0, 3]", "page_size": "value must be inside range [0, 100]"},
+		},
+		{
+			name: "without rules",
+			req:  struct{}{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			called := false
+			handler := validator()(func(ctx context.Context, req interface{}) (interface{}, error) {
+				called = true
+				return nil, nil
+			})
+			_, err := handler(context.Background(), tt.req)
+			if tt.fields == nil {
+				if err != nil || !called {
+					t.Fatalf("validator() error = %v, handler called = %v, want the request passed through", err, called)
+				}
+				return
+			}
+			if called {
+				t.Fatal("handler called with an invalid request")
+			}
+			if !operate.IsInvalidContent(err) {
+				t.Fatalf("validator() error = %v, want INVALID_CONTENT", err)
+			}
+			var e *kerrors.Error
+			if !errors.As(err, &e) || e.Code != 400 {
+				t.Fatalf("validator() error = %v, want code 400", err)
+			}
+			if len(e.Metadata) != len(tt.fields) {
+				t.Fatalf("metadata = %v, want %v", e.Metadata, tt.fields)
+			}
+			for field, reason := range tt.fields {
+				if got, ok := e.Metadata[field]; !ok || !strings.Contains(got, reason) {
+					t.Fatalf("metadata[%s] = %q, want %q; metadata = %v", field, got, reason, e.Metadata)
+				}
+			}
+		})
+	}
+}
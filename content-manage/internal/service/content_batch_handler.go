@@ -0,0 +1,63 @@
+package service
+
+import (
+	"content_manage/api/operate"
+	"content_manage/internal/biz"
+	"context"
+
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+func (a *AppService) BatchCreateContent(ctx context.Context, req *operate.BatchCreateContentReq) (*operate.BatchCreateContentRsp, error) {
+	uc := a.uc
+	contents := make([]*biz.Content, 0, len(req.GetContents()))
+	for _, content := range req.GetContents() {
+		contents = append(contents, fromContent(content))
+	}
+	results, err := uc.BatchCreateContent(ctx, contents, req.GetAtomic())
+	if err != nil {
+		return nil, err
+	}
+
+	return &operate.BatchCreateContentRsp{Results: toBatchResults(results)}, nil
+}
+
+func (a *AppService) BatchGetContent(ctx context.Context, req *operate.BatchGetContentReq) (*operate.BatchGetContentRsp, error) {
+	uc := a.uc
+	results, err := uc.BatchGetContent(ctx, req.GetIds())
+	if err != nil {
+		return nil, err
+	}
+
+	return &operate.BatchGetContentRsp{Results: toBatchResults(results)}, nil
+}
+
+func (a *AppService) BatchDeleteContent(ctx context.Context, req *operate.BatchDeleteContentReq) (*operate.BatchDeleteContentRsp, error) {
+	uc := a.uc
+	results, err := uc.BatchDeleteContent(ctx, req.GetIds(), req.GetAtomic())
+	if err != nil {
+		return nil, err
+	}
+
+	return &operate.BatchDeleteContentRsp{Results: toBatchResults(results)}, nil
+}
+
+func toBatchResults(results []*biz.BatchResult) []*operate.BatchResult {
+	items := make([]*operate.BatchResult, 0, len(results))
+	for _, result := range results {
+		item := &operate.BatchResult{}
+		if result.Content != nil {
+			item.Content = toContent(result.Content)
+		}
+		if result.Err != nil {
+			e := errors.FromError(biz.MapError(result.Err))
+			item.Error = &operate.BatchError{
+				Code:    e.Code,
+				Reason:  e.Reason,
+				Message: e.Message,
+			}
+		}
+		items = append(items, item)
+	}
+	return items
+}
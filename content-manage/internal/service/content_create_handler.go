@@ -1,69 +1,42 @@
 package service
 
 import (
-	"bytes"
 	"content_manage/api/operate"
 	"content_manage/internal/biz"
 	"context"
-	"encoding/json"
-	"fmt"
-	"net/http"
-	"time"
-
-	"github.com/google/uuid"
 )
 
 func (a *AppService) CreateContent(ctx context.Context, req *operate.CreateContentReq) (*operate.CreateContentRsp, error) {
 	content := req.GetContent()
 	uc := a.uc
-	contentID := uuid.New().String()
-	_, err := uc.CreateContent(ctx, &biz.Content{
-		ContentID:      contentID,
+	// 工作流由 outbox 在事务提交后触发, 幂等重试不会重复触发
+	id, _, err := uc.CreateContent(ctx, fromContent(content), req.GetIdempotencyKey())
+	if err != nil {
+		return nil, err
+	}
+	// 刚写入的内容从主库读取, 从库可能尚未同步
+	result, err := uc.GetContent(biz.WithPrimary(ctx), id, "")
+	if err != nil {
+		return nil, err
+	}
+
+	return &operate.CreateContentRsp{Content: toContent(result)}, nil
+}
+
+// fromContent 转换为新内容, id 和 content_id 由 biz 在写入前分配
+func fromContent(content *operate.Content) *biz.Content {
+	return &biz.Content{
 		Title:          content.GetTitle(),
 		VideoURL:       content.GetVideoUrl(),
 		Author:         content.GetAuthor(),
 		Description:    content.GetDescription(),
 		Thumbnail:      content.GetThumbnail(),
 		Category:       content.GetCategory(),
-		Duration:       time.Duration(content.GetDuration()),
+		Duration:       content.GetDuration().AsDuration(),
 		Resolution:     content.GetResolution(),
 		FileSize:       content.GetFileSize(),
 		Format:         content.GetFormat(),
 		Quality:        content.GetQuality(),
 		ApprovalStatus: content.GetApprovalStatus(),
-	})
-	if err != nil {
-		return nil, err
-	}
-	// 执行工作流
-	if err := a.ExecFlow(contentID); err != nil {
-		return nil, err
 	}
-
-	return &operate.CreateContentRsp{}, nil
-}
-
-func (a *AppService) ExecFlow(contentID string) error {
-	url := "http://localhost:7788/flow/content-flow"
-	method := "GET"
-
-	payload := map[string]interface{}{
-		"content_id": contentID,
-	}
-	data, _ := json.Marshal(payload)
-
-	client := &http.Client{}
-	req, err := http.NewRequest(method, url, bytes.NewReader(data))
-
-	if err != nil {
-		return err
-	}
-	req.Header.Add("Content-Type", "application/json")
-	_, err = client.Do(req)
-	if err != nil {
-		fmt.Println(err)
-		return err
-	}
-
-	return nil
 }
@@ -7,7 +7,7 @@ import (
 
 func (a *AppService) DeleteContent(ctx context.Context, req *operate.DeleteContentReq) (*operate.DeleteContentRsp, error) {
 	uc := a.uc
-	err := uc.DeleteContent(ctx, req.GetId())
+	err := uc.DeleteContent(ctx, req.GetId(), req.GetExpectedVersion())
 	if err != nil {
 		return nil, err
 	}
@@ -4,43 +4,87 @@ import (
 	"content_manage/api/operate"
 	"content_manage/internal/biz"
 	"context"
+	"time"
+
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func (a *AppService) FindContent(ctx context.Context, req *operate.FindContentReq) (*operate.FindContentRsp, error) {
 	findParams := &biz.FindParams{
-		ID:       req.GetId(),
-		Author:   req.GetAuthor(),
-		Title:    req.GetTitle(),
-		Page:     req.GetPage(),
-		PageSize: req.GetPageSize(),
+		ID:             req.GetId(),
+		Author:         req.GetAuthor(),
+		Title:          req.GetTitle(),
+		Category:       req.GetCategory(),
+		ApprovalStatus: req.GetApprovalStatus(),
+		Quality:        req.GetQuality(),
+		Format:         req.GetFormat(),
+		Resolution:     req.GetResolution(),
+		CreatedAfter:   asTime(req.GetCreatedAfter()),
+		CreatedBefore:  asTime(req.GetCreatedBefore()),
+		UpdatedAfter:   asTime(req.GetUpdatedAfter()),
+		UpdatedBefore:  asTime(req.GetUpdatedBefore()),
+		MinDuration:    req.GetMinDuration().AsDuration(),
+		MaxDuration:    req.GetMaxDuration().AsDuration(),
+		SortBy:         biz.SortBy(req.GetSortBy()),
+		Desc:           req.GetOrder() == operate.SortOrder_SORT_ORDER_DESC,
+		Page:           req.GetPage(),
+		PageSize:       req.GetPageSize(),
+		PageToken:      req.GetPageToken(),
+		SkipTotal:      req.GetSkipTotal(),
 	}
 	uc := a.uc
-	results, total, err := uc.FindContent(ctx, findParams)
+	results, total, nextPageToken, err := uc.FindContent(ctx, findParams)
 	if err != nil {
 		return nil, err
 	}
 
 	contents := make([]*operate.Content, 0, len(results))
 	for _, result := range results {
-		contents = append(contents, &operate.Content{
-			Id:             result.ID,
-			Title:          result.Title,
-			VideoUrl:       result.VideoURL,
-			Author:         result.Author,
-			Description:    result.Description,
-			Thumbnail:      result.Thumbnail,
-			Category:       result.Category,
-			Duration:       result.Duration.Microseconds(),
-			Resolution:     result.Resolution,
-			FileSize:       result.FileSize,
-			Format:         result.Format,
-			Quality:        result.Quality,
-			ApprovalStatus: result.ApprovalStatus,
-		})
+		contents = append(contents, toContent(result))
 	}
 
 	return &operate.FindContentRsp{
-		Contents: contents,
-		Total:    total,
+		Contents:      contents,
+		Total:         total,
+		NextPageToken: nextPageToken,
 	}, nil
 }
+
+// asTime 未设置的时间返回零值
+func asTime(ts *timestamppb.Timestamp) time.Time {
+	if ts == nil {
+		return time.Time{}
+	}
+	return ts.AsTime()
+}
+
+func toContent(c *biz.Content) *operate.Content {
+	content := &operate.Content{
+		Id:             c.ID,
+		ContentId:      c.ContentID,
+		Title:          c.Title,
+		VideoUrl:       c.VideoURL,
+		Author:         c.Author,
+		Description:    c.Description,
+		Thumbnail:      c.Thumbnail,
+		Category:       c.Category,
+		Duration:       durationpb.New(c.Duration),
+		Resolution:     c.Resolution,
+		FileSize:       c.FileSize,
+		Format:         c.Format,
+		Quality:        c.Quality,
+		ApprovalStatus: c.ApprovalStatus,
+		Version:        c.Version,
+	}
+	if !c.CreatedAt.IsZero() {
+		content.CreatedAt = timestamppb.New(c.CreatedAt)
+	}
+	if !c.UpdatedAt.IsZero() {
+		content.UpdatedAt = timestamppb.New(c.UpdatedAt)
+	}
+	if !c.DeletedAt.IsZero() {
+		content.DeletedAt = timestamppb.New(c.DeletedAt)
+	}
+	return content
+}
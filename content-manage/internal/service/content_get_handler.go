@@ -0,0 +1,16 @@
+package service
+
+import (
+	"content_manage/api/operate"
+	"context"
+)
+
+func (a *AppService) GetContent(ctx context.Context, req *operate.GetContentReq) (*operate.GetContentRsp, error) {
+	uc := a.uc
+	content, err := uc.GetContent(ctx, req.GetId(), req.GetContentId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &operate.GetContentRsp{Content: toContent(content)}, nil
+}
@@ -0,0 +1,81 @@
+package service
+
+import (
+	"content_manage/api/operate"
+	"content_manage/internal/biz"
+	"context"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (a *AppService) ListRevisions(ctx context.Context, req *operate.ListRevisionsReq) (*operate.ListRevisionsRsp, error) {
+	uc := a.uc
+	results, total, err := uc.ListRevisions(ctx, req.GetId(), req.GetPage(), req.GetPageSize())
+	if err != nil {
+		return nil, err
+	}
+
+	revisions := make([]*operate.Revision, 0, len(results))
+	for _, result := range results {
+		revisions = append(revisions, toRevision(result))
+	}
+
+	return &operate.ListRevisionsRsp{
+		Total:     total,
+		Revisions: revisions,
+	}, nil
+}
+
+func (a *AppService) GetRevision(ctx context.Context, req *operate.GetRevisionReq) (*operate.GetRevisionRsp, error) {
+	uc := a.uc
+	result, err := uc.GetRevision(ctx, req.GetId(), req.GetRevision())
+	if err != nil {
+		return nil, err
+	}
+	return &operate.GetRevisionRsp{Revision: toRevision(result)}, nil
+}
+
+func (a *AppService) DiffRevisions(ctx context.Context, req *operate.DiffRevisionsReq) (*operate.DiffRevisionsRsp, error) {
+	uc := a.uc
+	results, err := uc.DiffRevisions(ctx, req.GetId(), req.GetFrom(), req.GetTo())
+	if err != nil {
+		return nil, err
+	}
+
+	diffs := make([]*operate.FieldDiff, 0, len(results))
+	for _, result := range results {
+		diffs = append(diffs, &operate.FieldDiff{
+			Field: result.Field,
+			From:  result.From,
+			To:    result.To,
+		})
+	}
+	return &operate.DiffRevisionsRsp{Diffs: diffs}, nil
+}
+
+func (a *AppService) RollbackContent(ctx context.Context, req *operate.RollbackContentReq) (*operate.RollbackContentRsp, error) {
+	uc := a.uc
+	result, err := uc.RollbackContent(ctx, req.GetId(), req.GetRevision(), req.GetEditor())
+	if err != nil {
+		return nil, err
+	}
+	rsp := &operate.RollbackContentRsp{}
+	if result != nil {
+		rsp.Revision = result.Revision
+	}
+	return rsp, nil
+}
+
+func toRevision(r *biz.Revision) *operate.Revision {
+	revision := &operate.Revision{
+		ContentId:     r.ContentID,
+		Revision:      r.Revision,
+		Editor:        r.Editor,
+		ChangedFields: r.ChangedFields,
+		CreatedAt:     timestamppb.New(r.CreatedAt),
+	}
+	if r.Content != nil {
+		revision.Content = toContent(r.Content)
+	}
+	return revision
+}
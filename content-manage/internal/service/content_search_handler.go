@@ -0,0 +1,29 @@
+package service
+
+import (
+	"content_manage/api/operate"
+	"context"
+)
+
+func (a *AppService) SearchContent(ctx context.Context, req *operate.SearchContentReq) (*operate.SearchContentRsp, error) {
+	uc := a.uc
+	results, total, err := uc.SearchContent(ctx, req.GetQuery(), req.GetPage(), req.GetPageSize())
+	if err != nil {
+		return nil, err
+	}
+
+	hits := make([]*operate.SearchHit, 0, len(results))
+	for _, result := range results {
+		hits = append(hits, &operate.SearchHit{
+			Content:              toContent(result.Content),
+			Score:                result.Score,
+			TitleHighlight:       result.TitleHighlight,
+			DescriptionHighlight: result.DescriptionHighlight,
+		})
+	}
+
+	return &operate.SearchContentRsp{
+		Total: total,
+		Hits:  hits,
+	}, nil
+}
@@ -0,0 +1,33 @@
+package service
+
+import (
+	"content_manage/api/operate"
+	"content_manage/internal/biz"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/encoding"
+	"github.com/go-kratos/kratos/v2/encoding/json"
+)
+
+func TestContentDurationRoundTrip(t *testing.T) {
+	codec := encoding.GetCodec(json.Name)
+	for _, d := range []time.Duration{0, time.Nanosecond, 90*time.Minute + time.Nanosecond} {
+		data, err := codec.Marshal(toContent(&biz.Content{Duration: d}))
+		if err != nil {
+			t.Fatal(err)
+		}
+		// google.protobuf.Duration 在 json 中是带单位的字符串, 不会被当作毫秒或微秒
+		if !strings.Contains(string(data), `"duration":"`) {
+			t.Fatalf("json = %s, want duration as a string", data)
+		}
+		var content operate.Content
+		if err := codec.Unmarshal(data, &content); err != nil {
+			t.Fatal(err)
+		}
+		if got := fromContent(&content).Duration; got != d {
+			t.Fatalf("duration round trip = %s, want %s", got, d)
+		}
+	}
+}
@@ -0,0 +1,40 @@
+package service
+
+import (
+	"content_manage/api/operate"
+	"context"
+)
+
+func (a *AppService) ListDeletedContent(ctx context.Context, req *operate.ListDeletedContentReq) (*operate.ListDeletedContentRsp, error) {
+	uc := a.uc
+	results, total, err := uc.ListDeletedContent(ctx, req.GetPage(), req.GetPageSize())
+	if err != nil {
+		return nil, err
+	}
+
+	contents := make([]*operate.Content, 0, len(results))
+	for _, result := range results {
+		contents = append(contents, toContent(result))
+	}
+
+	return &operate.ListDeletedContentRsp{
+		Total:    total,
+		Contents: contents,
+	}, nil
+}
+
+func (a *AppService) RestoreContent(ctx context.Context, req *operate.RestoreContentReq) (*operate.RestoreContentRsp, error) {
+	uc := a.uc
+	if err := uc.RestoreContent(ctx, req.GetId()); err != nil {
+		return nil, err
+	}
+	return &operate.RestoreContentRsp{}, nil
+}
+
+func (a *AppService) PurgeContent(ctx context.Context, req *operate.PurgeContentReq) (*operate.PurgeContentRsp, error) {
+	uc := a.uc
+	if err := uc.PurgeContent(ctx, req.GetId()); err != nil {
+		return nil, err
+	}
+	return &operate.PurgeContentRsp{}, nil
+}
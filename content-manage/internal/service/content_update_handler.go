@@ -4,13 +4,14 @@ import (
 	"content_manage/api/operate"
 	"content_manage/internal/biz"
 	"context"
-	"time"
+
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
 )
 
 func (a *AppService) UpdateContent(ctx context.Context, req *operate.UpdateContentReq) (*operate.UpdateContentRsp, error) {
 	content := req.GetContent()
 	uc := a.uc
-	err := uc.UpdateContent(ctx, &biz.Content{
+	result, err := uc.UpdateContent(ctx, &biz.Content{
 		ID:             content.GetId(),
 		Title:          content.GetTitle(),
 		VideoURL:       content.GetVideoUrl(),
@@ -18,16 +19,25 @@ func (a *AppService) UpdateContent(ctx context.Context, req *operate.UpdateConte
 		Description:    content.GetDescription(),
 		Thumbnail:      content.GetThumbnail(),
 		Category:       content.GetCategory(),
-		Duration:       time.Duration(content.GetDuration()),
+		Duration:       content.GetDuration().AsDuration(),
 		Resolution:     content.GetResolution(),
 		FileSize:       content.GetFileSize(),
 		Format:         content.GetFormat(),
 		Quality:        content.GetQuality(),
 		ApprovalStatus: content.GetApprovalStatus(),
-	})
+		Version:        req.GetExpectedVersion(),
+	}, updateFields(req.GetUpdateMask()), req.GetEditor())
 	if err != nil {
 		return nil, err
 	}
 
-	return &operate.UpdateContentRsp{}, nil
+	return &operate.UpdateContentRsp{Content: toContent(result)}, nil
+}
+
+// updateFields 未传 update_mask 时返回 nil
+func updateFields(mask *fieldmaskpb.FieldMask) []string {
+	if mask == nil {
+		return nil
+	}
+	return append([]string{}, mask.GetPaths()...)
 }
@@ -0,0 +1,33 @@
+package service
+
+import (
+	"content_manage/api/operate"
+	"content_manage/internal/biz"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (a *AppService) WatchContent(req *operate.WatchContentReq, stream operate.App_WatchContentServer) error {
+	uc := a.uc
+	filter := &biz.WatchFilter{
+		Author:         req.GetAuthor(),
+		Category:       req.GetCategory(),
+		ApprovalStatus: req.GetApprovalStatus(),
+	}
+	return uc.WatchContent(stream.Context(), filter, req.GetResumeToken(),
+		func(e *biz.ContentEvent) error {
+			return stream.Send(&operate.WatchContentRsp{
+				Type:          string(e.Type),
+				Content:       toContent(e.Content),
+				ChangedFields: e.ChangedFields,
+				Editor:        e.Editor,
+				ApprovalFrom:  e.ApprovalFrom,
+				EventId:       e.ID,
+				OccurredAt:    timestamppb.New(e.OccurredAt),
+				ResumeToken:   e.Offset,
+			})
+		},
+		func(token string) error {
+			return stream.Send(&operate.WatchContentRsp{ResumeToken: token, Heartbeat: true})
+		})
+}
@@ -0,0 +1,49 @@
+-- 由 cmd/migrate 按 configs/config.yaml 的默认分片布局生成, 修改请改 cmd/migrate/duration.go 后重新生成:
+--   go run ./cmd/migrate -conf configs duration > script/duration.sql
+-- 详情表分布到多个物理库 (shard.databases) 或配置了重分片目标布局 (reshard.target) 时不要执行本文件,
+-- 按实际配置生成, 每个 "-- database:" 下的语句在对应的库中执行:
+--   go run ./cmd/migrate -conf <配置> duration
+--   go run ./cmd/migrate -conf <配置> -database shard_a duration | mysql ...
+
+-- database: primary
+-- 时长统一为纳秒, 与 time.Duration 和 google.protobuf.Duration 一致
+-- 旧版网关按微秒传入, content-manage 按纳秒原样写入, 已有数据需乘以 1000
+-- 执行记录在本库的 t_schema_migrations 中, 重复执行时不再修改数据
+-- 执行前停止写入, 执行后清理内容缓存 cms:content:*, 缓存中仍是旧值
+
+-- int 改为 bigint 避免纳秒溢出
+ALTER TABLE `cms_content`.`t_content_details_0`
+    MODIFY COLUMN `duration` bigint NOT NULL DEFAULT 0 COMMENT '时长, 单位纳秒';
+ALTER TABLE `cms_content`.`t_content_details_1`
+    MODIFY COLUMN `duration` bigint NOT NULL DEFAULT 0 COMMENT '时长, 单位纳秒';
+ALTER TABLE `cms_content`.`t_content_details_2`
+    MODIFY COLUMN `duration` bigint NOT NULL DEFAULT 0 COMMENT '时长, 单位纳秒';
+ALTER TABLE `cms_content`.`t_content_details_3`
+    MODIFY COLUMN `duration` bigint NOT NULL DEFAULT 0 COMMENT '时长, 单位纳秒';
+ALTER TABLE `cms_content`.`t_idx_content_details`
+    MODIFY COLUMN `duration` bigint NOT NULL DEFAULT 0 COMMENT '时长, 单位纳秒';
+
+CREATE TABLE IF NOT EXISTS `cms_content`.`t_schema_migrations` (
+    `name` varchar(64) NOT NULL COMMENT '迁移名称',
+    `applied_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '执行时间',
+    PRIMARY KEY (`name`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='已执行的数据迁移';
+
+-- 数据修改和执行记录在同一个事务中, 已有记录时 @apply 为 0, 以下修改都不生效
+START TRANSACTION;
+SELECT COUNT(*) = 0 INTO @apply FROM `cms_content`.`t_schema_migrations` WHERE `name` = 'duration_ns' FOR UPDATE;
+
+-- updated_at 保持不变, 迁移不算作修改
+UPDATE `cms_content`.`t_content_details_0` SET `duration` = `duration` * 1000, `updated_at` = `updated_at` WHERE @apply;
+UPDATE `cms_content`.`t_content_details_1` SET `duration` = `duration` * 1000, `updated_at` = `updated_at` WHERE @apply;
+UPDATE `cms_content`.`t_content_details_2` SET `duration` = `duration` * 1000, `updated_at` = `updated_at` WHERE @apply;
+UPDATE `cms_content`.`t_content_details_3` SET `duration` = `duration` * 1000, `updated_at` = `updated_at` WHERE @apply;
+UPDATE `cms_content`.`t_idx_content_details` SET `duration` = `duration` * 1000, `updated_at` = `updated_at` WHERE @apply;
+
+-- 历史版本快照中的时长同样按纳秒保存
+UPDATE `cms_content`.`t_content_revisions`
+SET `snapshot` = JSON_SET(`snapshot`, '$.duration', CAST(JSON_EXTRACT(`snapshot`, '$.duration') AS SIGNED) * 1000)
+WHERE @apply AND JSON_EXTRACT(`snapshot`, '$.duration') IS NOT NULL;
+
+INSERT IGNORE INTO `cms_content`.`t_schema_migrations` (`name`) VALUES ('duration_ns');
+COMMIT;
@@ -0,0 +1,9 @@
+CREATE TABLE `cms_content`.`t_idempotency_keys` (
+    `idempotency_key` varchar(128) NOT NULL COMMENT '幂等键',
+    `fingerprint` char(64) NOT NULL DEFAULT '' COMMENT '请求内容的 sha256',
+    `content_id` bigint NOT NULL DEFAULT 0 COMMENT '创建的索引表ID',
+    `expires_at` timestamp NOT NULL COMMENT '过期时间',
+    `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+    PRIMARY KEY (`idempotency_key`),
+    KEY `idx_expires_at` (`expires_at`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='创建内容幂等记录表';
@@ -0,0 +1,37 @@
+-- 索引表冗余筛选/排序字段, 查询时不必扫描所有详情表
+ALTER TABLE `cms_content`.`t_idx_content_details`
+    ADD COLUMN `category` varchar(64) NOT NULL DEFAULT '' COMMENT '分类' AFTER `author`,
+    ADD COLUMN `duration` bigint NOT NULL DEFAULT 0 COMMENT '时长' AFTER `category`,
+    ADD COLUMN `resolution` varchar(64) NOT NULL DEFAULT '' COMMENT '分辨率' AFTER `duration`,
+    ADD COLUMN `file_size` bigint NOT NULL DEFAULT 0 COMMENT '文件大小' AFTER `resolution`,
+    ADD COLUMN `format` varchar(64) NOT NULL DEFAULT '' COMMENT '格式' AFTER `file_size`,
+    ADD COLUMN `quality` int NOT NULL DEFAULT 0 COMMENT '视频质量 1-高清 2-标清 3-流畅' AFTER `format`,
+    ADD COLUMN `approval_status` int NOT NULL DEFAULT 0 COMMENT '审核状态 0-未审核 1-审核中 2-审核通过 3-审核不通过' AFTER `quality`,
+    ADD KEY `idx_created_at` (`created_at`, `id`),
+    ADD KEY `idx_updated_at` (`updated_at`, `id`),
+    ADD KEY `idx_duration` (`duration`, `id`),
+    ADD KEY `idx_file_size` (`file_size`, `id`),
+    ADD KEY `idx_category` (`category`, `created_at`),
+    ADD KEY `idx_approval_status` (`approval_status`, `created_at`);
+
+-- 从详情表回填已有数据, 每张详情表执行一次
+UPDATE `cms_content`.`t_idx_content_details` i
+    JOIN `cms_content`.`t_content_details_0` d ON d.`content_id` = i.`content_id`
+SET i.`category` = d.`category`, i.`duration` = d.`duration`, i.`resolution` = d.`resolution`,
+    i.`file_size` = d.`file_size`, i.`format` = d.`format`, i.`quality` = d.`quality`,
+    i.`approval_status` = d.`approval_status`, i.`updated_at` = i.`updated_at`;
+UPDATE `cms_content`.`t_idx_content_details` i
+    JOIN `cms_content`.`t_content_details_1` d ON d.`content_id` = i.`content_id`
+SET i.`category` = d.`category`, i.`duration` = d.`duration`, i.`resolution` = d.`resolution`,
+    i.`file_size` = d.`file_size`, i.`format` = d.`format`, i.`quality` = d.`quality`,
+    i.`approval_status` = d.`approval_status`, i.`updated_at` = i.`updated_at`;
+UPDATE `cms_content`.`t_idx_content_details` i
+    JOIN `cms_content`.`t_content_details_2` d ON d.`content_id` = i.`content_id`
+SET i.`category` = d.`category`, i.`duration` = d.`duration`, i.`resolution` = d.`resolution`,
+    i.`file_size` = d.`file_size`, i.`format` = d.`format`, i.`quality` = d.`quality`,
+    i.`approval_status` = d.`approval_status`, i.`updated_at` = i.`updated_at`;
+UPDATE `cms_content`.`t_idx_content_details` i
+    JOIN `cms_content`.`t_content_details_3` d ON d.`content_id` = i.`content_id`
+SET i.`category` = d.`category`, i.`duration` = d.`duration`, i.`resolution` = d.`resolution`,
+    i.`file_size` = d.`file_size`, i.`format` = d.`format`, i.`quality` = d.`quality`,
+    i.`approval_status` = d.`approval_status`, i.`updated_at` = i.`updated_at`;
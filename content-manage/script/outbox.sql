@@ -0,0 +1,13 @@
+CREATE TABLE `cms_content`.`t_outbox` (
+    `id` bigint NOT NULL AUTO_INCREMENT COMMENT '自增ID',
+    `topic` varchar(64) NOT NULL COMMENT '消息类型',
+    `content_id` varchar(64) NOT NULL DEFAULT '' COMMENT '内容ID, 同一类型同一内容的消息按顺序投递',
+    `payload` json NOT NULL COMMENT '消息内容',
+    `attempts` int NOT NULL DEFAULT 0 COMMENT '已失败的投递次数',
+    `last_error` varchar(512) NOT NULL DEFAULT '' COMMENT '最近一次失败原因',
+    `next_attempt_at` timestamp(3) NOT NULL DEFAULT CURRENT_TIMESTAMP(3) COMMENT '下次投递时间',
+    `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+    PRIMARY KEY (`id`),
+    KEY `idx_next_attempt_at` (`next_attempt_at`),
+    KEY `idx_content_id` (`topic`, `content_id`, `id`)
+) ENGINE=InnoDB AUTO_INCREMENT=1 DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='待投递消息表';
@@ -0,0 +1,18 @@
+CREATE TABLE `cms_content`.`t_reshard_jobs` (
+    `id` bigint NOT NULL AUTO_INCREMENT COMMENT '任务ID',
+    `source_version` varchar(64) NOT NULL DEFAULT '' COMMENT '原分片拓扑版本',
+    `target_version` varchar(64) NOT NULL DEFAULT '' COMMENT '目标分片拓扑版本',
+    `phase` varchar(32) NOT NULL DEFAULT '' COMMENT '阶段 dual_write backfill verify cutover done aborted',
+    `last_id` bigint NOT NULL DEFAULT 0 COMMENT '当前阶段处理到的索引表id',
+    `total` bigint NOT NULL DEFAULT 0 COMMENT '需要回填的行数',
+    `copied` bigint NOT NULL DEFAULT 0 COMMENT '已回填行数',
+    `verified` bigint NOT NULL DEFAULT 0 COMMENT '已校验行数',
+    `mismatched` bigint NOT NULL DEFAULT 0 COMMENT '校验不一致并已修复的行数',
+    `cleaned` bigint NOT NULL DEFAULT 0 COMMENT '已清理的旧表残留行数',
+    `phase_done` tinyint(1) NOT NULL DEFAULT 0 COMMENT '当前阶段是否处理完成',
+    `phase_changed_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '进入当前阶段的时间',
+    `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+    `updated_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
+    PRIMARY KEY (`id`),
+    KEY `idx_version` (`source_version`, `target_version`)
+) ENGINE=InnoDB AUTO_INCREMENT=1 DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='重分片任务表';
@@ -0,0 +1,11 @@
+CREATE TABLE `cms_content`.`t_content_revisions` (
+    `id` bigint NOT NULL AUTO_INCREMENT COMMENT '自增ID',
+    `content_id` bigint NOT NULL COMMENT '索引表ID',
+    `revision` int NOT NULL COMMENT '版本号, 同一内容内从 1 递增',
+    `editor` varchar(64) NOT NULL DEFAULT '' COMMENT '修改人',
+    `changed_fields` varchar(512) NOT NULL DEFAULT '' COMMENT '修改的字段, 逗号分隔',
+    `snapshot` json NOT NULL COMMENT '修改后的完整内容',
+    `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+    PRIMARY KEY (`id`),
+    UNIQUE KEY `uk_content_revision` (`content_id`, `revision`)
+) ENGINE=InnoDB AUTO_INCREMENT=1 DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='内容历史版本表';
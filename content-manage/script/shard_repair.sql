@@ -0,0 +1,9 @@
+-- 详情表分布到多个分片库时使用, 分片库先于主库提交, 主库回滚后据此恢复分片库中的详情
+CREATE TABLE `cms_content`.`t_shard_repairs` (
+    `id` bigint NOT NULL AUTO_INCREMENT COMMENT '自增ID',
+    `snapshot` json NOT NULL COMMENT '分片库中被修改的详情在修改前的内容',
+    `committed` tinyint(1) NOT NULL DEFAULT 0 COMMENT '主库事务是否已提交',
+    `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+    PRIMARY KEY (`id`),
+    KEY `idx_created_at` (`created_at`)
+) ENGINE=InnoDB AUTO_INCREMENT=1 DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='分片库补偿记录表';
@@ -0,0 +1,14 @@
+-- 软删除, deleted_at 非空表示内容在回收站中
+ALTER TABLE `cms_content`.`t_idx_content_details`
+    ADD COLUMN `deleted_at` datetime(3) NULL DEFAULT NULL COMMENT '删除时间' AFTER `updated_at`,
+    ADD KEY `idx_deleted_at` (`deleted_at`);
+
+-- 每张详情表执行一次
+ALTER TABLE `cms_content`.`t_content_details_0`
+    ADD COLUMN `deleted_at` datetime(3) NULL DEFAULT NULL COMMENT '删除时间' AFTER `updated_at`;
+ALTER TABLE `cms_content`.`t_content_details_1`
+    ADD COLUMN `deleted_at` datetime(3) NULL DEFAULT NULL COMMENT '删除时间' AFTER `updated_at`;
+ALTER TABLE `cms_content`.`t_content_details_2`
+    ADD COLUMN `deleted_at` datetime(3) NULL DEFAULT NULL COMMENT '删除时间' AFTER `updated_at`;
+ALTER TABLE `cms_content`.`t_content_details_3`
+    ADD COLUMN `deleted_at` datetime(3) NULL DEFAULT NULL COMMENT '删除时间' AFTER `updated_at`;
@@ -0,0 +1,13 @@
+-- 乐观锁版本号, 每次更新加 1, 已有数据从 1 开始
+ALTER TABLE `cms_content`.`t_idx_content_details`
+    ADD COLUMN `version` bigint NOT NULL DEFAULT 1 COMMENT '版本号' AFTER `approval_status`;
+
+-- 每张详情表执行一次
+ALTER TABLE `cms_content`.`t_content_details_0`
+    ADD COLUMN `version` bigint NOT NULL DEFAULT 1 COMMENT '版本号' AFTER `approval_status`;
+ALTER TABLE `cms_content`.`t_content_details_1`
+    ADD COLUMN `version` bigint NOT NULL DEFAULT 1 COMMENT '版本号' AFTER `approval_status`;
+ALTER TABLE `cms_content`.`t_content_details_2`
+    ADD COLUMN `version` bigint NOT NULL DEFAULT 1 COMMENT '版本号' AFTER `approval_status`;
+ALTER TABLE `cms_content`.`t_content_details_3`
+    ADD COLUMN `version` bigint NOT NULL DEFAULT 1 COMMENT '版本号' AFTER `approval_status`;
@@ -0,0 +1,3 @@
+module github.com/zerokkcoder/content-shard
+
+go 1.21
@@ -0,0 +1,89 @@
+package shard
+
+import (
+	"fmt"
+	"hash/fnv"
+	"math"
+	"math/big"
+	"sort"
+)
+
+type picker interface {
+	pick(hash uint64) int
+}
+
+// modPicker 哈希取模. 与历史实现保持一致: 哈希值按 int64 解释后取欧几里得模
+type modPicker struct {
+	mod *big.Int
+}
+
+func newModPicker(shards int) *modPicker {
+	return &modPicker{mod: big.NewInt(int64(shards))}
+}
+
+func (p *modPicker) pick(hash uint64) int {
+	bigNum := big.NewInt(int64(hash))
+	return int(bigNum.Mod(bigNum, p.mod).Int64())
+}
+
+// ringPicker 一致性哈希环, 增加分片时只有少量 key 需要迁移
+type ringPicker struct {
+	points []uint64
+	shards map[uint64]int
+}
+
+func newRingPicker(shards, replicas int) *ringPicker {
+	p := &ringPicker{
+		points: make([]uint64, 0, shards*replicas),
+		shards: make(map[uint64]int, shards*replicas),
+	}
+	for i := 0; i < shards; i++ {
+		for v := 0; v < replicas; v++ {
+			hash := fnv.New64a()
+			fmt.Fprintf(hash, "shard-%d#%d", i, v)
+			point := hash.Sum64()
+			if _, ok := p.shards[point]; ok {
+				continue
+			}
+			p.shards[point] = i
+			p.points = append(p.points, point)
+		}
+	}
+	sort.Slice(p.points, func(i, j int) bool { return p.points[i] < p.points[j] })
+	return p
+}
+
+func (p *ringPicker) pick(hash uint64) int {
+	i := sort.Search(len(p.points), func(i int) bool { return p.points[i] >= hash })
+	if i == len(p.points) {
+		i = 0
+	}
+	return p.shards[p.points[i]]
+}
+
+// rangePicker 按哈希值区间划分, bounds[i] 为第 i 个分片的上界(不含)
+type rangePicker struct {
+	bounds []uint64
+}
+
+func newRangePicker(shards int, bounds []uint64) (*rangePicker, error) {
+	if len(bounds) == 0 {
+		step := math.MaxUint64 / uint64(shards)
+		for i := 1; i < shards; i++ {
+			bounds = append(bounds, step*uint64(i))
+		}
+	}
+	if len(bounds) != shards-1 {
+		return nil, fmt.Errorf("shard: range strategy needs %d bounds, got %d", shards-1, len(bounds))
+	}
+	for i := 1; i < len(bounds); i++ {
+		if bounds[i] <= bounds[i-1] {
+			return nil, fmt.Errorf("shard: range bounds must be ascending")
+		}
+	}
+	return &rangePicker{bounds: bounds}, nil
+}
+
+func (p *rangePicker) pick(hash uint64) int {
+	return sort.Search(len(p.bounds), func(i int) bool { return hash < p.bounds[i] })
+}
@@ -0,0 +1,186 @@
+// Package shard 内容详情表的分片路由, content-manage, content-flow 等服务共用,
+// 保证所有服务对同一个 content_id 路由到同一张详情表.
+package shard
+
+import (
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"hash/fnv"
+	"strings"
+)
+
+// Strategy 分片策略
+type Strategy string
+
+const (
+	// StrategyMod 哈希取模
+	StrategyMod Strategy = "mod"
+	// StrategyRing 一致性哈希环
+	StrategyRing Strategy = "ring"
+	// StrategyRange 按哈希值区间划分
+	StrategyRange Strategy = "range"
+)
+
+const (
+	defaultShards   = 4
+	defaultTable    = "cms_content.t_content_details_%d"
+	defaultReplicas = 64
+)
+
+// ErrTopologyMismatch 配置中标记的拓扑版本与实际配置计算出的版本不一致
+var ErrTopologyMismatch = errors.New("shard: topology version mismatch")
+
+// Config 分片配置
+type Config struct {
+	// 分片策略, 默认 mod
+	Strategy Strategy
+	// 分片数量, 默认 4
+	Shards int
+	// 详情表名格式, 默认 cms_content.t_content_details_%d
+	Table string
+	// ring 策略下每个分片的虚拟节点数, 默认 64
+	Replicas int
+	// range 策略下前 Shards-1 个分片的哈希上界(不含), 升序; 为空时均分哈希空间
+	Ranges []uint64
+	// 拓扑版本, 不为空时必须与配置计算出的版本一致
+	Version string
+}
+
+// DefaultConfig 与历史上 hard-code 的 fnv 取 4 模路由一致
+func DefaultConfig() Config {
+	return Config{
+		Strategy: StrategyMod,
+		Shards:   defaultShards,
+		Table:    defaultTable,
+	}
+}
+
+func (c Config) withDefaults() Config {
+	if c.Strategy == "" {
+		c.Strategy = StrategyMod
+	}
+	if c.Shards <= 0 {
+		c.Shards = defaultShards
+	}
+	if c.Table == "" {
+		c.Table = defaultTable
+	}
+	if c.Strategy == StrategyRing && c.Replicas <= 0 {
+		c.Replicas = defaultReplicas
+	}
+	if c.Strategy != StrategyRing {
+		c.Replicas = 0
+	}
+	if c.Strategy != StrategyRange {
+		c.Ranges = nil
+	}
+	return c
+}
+
+// Router 按 content_id 路由到详情表
+type Router struct {
+	cfg     Config
+	version string
+	picker  picker
+}
+
+// New 创建路由, 配置中带有 Version 时校验拓扑版本
+func New(cfg Config) (*Router, error) {
+	cfg = cfg.withDefaults()
+	var (
+		p   picker
+		err error
+	)
+	switch cfg.Strategy {
+	case StrategyMod:
+		p = newModPicker(cfg.Shards)
+	case StrategyRing:
+		p = newRingPicker(cfg.Shards, cfg.Replicas)
+	case StrategyRange:
+		p, err = newRangePicker(cfg.Shards, cfg.Ranges)
+	default:
+		err = fmt.Errorf("shard: unknown strategy %q", cfg.Strategy)
+	}
+	if err != nil {
+		return nil, err
+	}
+	version := fingerprint(cfg)
+	if cfg.Version != "" && cfg.Version != version {
+		return nil, fmt.Errorf("%w: configured %s, computed %s", ErrTopologyMismatch, cfg.Version, version)
+	}
+	cfg.Version = version
+	return &Router{cfg: cfg, version: version, picker: p}, nil
+}
+
+// MustNew 创建路由, 出错时 panic
+func MustNew(cfg Config) *Router {
+	r, err := New(cfg)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// Index 返回 key 所在的分片序号
+func (r *Router) Index(key string) int {
+	return r.picker.pick(hashKey(key))
+}
+
+// Table 返回 key 所在的详情表
+func (r *Router) Table(key string) string {
+	return r.TableAt(r.Index(key))
+}
+
+// TableAt 返回第 i 个分片的详情表
+func (r *Router) TableAt(i int) string {
+	return fmt.Sprintf(r.cfg.Table, i)
+}
+
+// Tables 返回全部详情表
+func (r *Router) Tables() []string {
+	tables := make([]string, 0, r.cfg.Shards)
+	for i := 0; i < r.cfg.Shards; i++ {
+		tables = append(tables, r.TableAt(i))
+	}
+	return tables
+}
+
+// Shards 返回分片数量
+func (r *Router) Shards() int {
+	return r.cfg.Shards
+}
+
+// Config 返回补全默认值并带上版本的配置
+func (r *Router) Config() Config {
+	return r.cfg
+}
+
+// Version 返回拓扑版本, 相同的版本保证相同的路由结果
+func (r *Router) Version() string {
+	return r.version
+}
+
+// Check 校验其他服务上报的拓扑版本与本地是否一致
+func (r *Router) Check(version string) error {
+	if version != r.version {
+		return fmt.Errorf("%w: local %s, remote %s", ErrTopologyMismatch, r.version, version)
+	}
+	return nil
+}
+
+func hashKey(key string) uint64 {
+	hash := fnv.New64()
+	hash.Write([]byte(key))
+	return hash.Sum64()
+}
+
+// fingerprint 由影响路由结果的配置项计算拓扑版本, 如 mod-4-aee9cadd
+func fingerprint(cfg Config) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s|%d|%s|%d", cfg.Strategy, cfg.Shards, cfg.Table, cfg.Replicas)
+	for _, bound := range cfg.Ranges {
+		fmt.Fprintf(&b, "|%d", bound)
+	}
+	return fmt.Sprintf("%s-%d-%08x", cfg.Strategy, cfg.Shards, crc32.ChecksumIEEE([]byte(b.String())))
+}
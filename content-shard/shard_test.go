@@ -0,0 +1,176 @@
+package shard
+
+import (
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"math/big"
+	"math/rand"
+	"testing"
+)
+
+// legacyTable 历史上 hard-code 的路由: fnv64 哈希按 int64 取 4 的欧几里得模
+func legacyTable(key string) string {
+	hash := fnv.New64()
+	hash.Write([]byte(key))
+	bigNum := big.NewInt(int64(hash.Sum64()))
+	return fmt.Sprintf("cms_content.t_content_details_%d", bigNum.Mod(bigNum, big.NewInt(4)).Int64())
+}
+
+// testKeys 生成固定种子的 uuid 形式 key, 与线上 content_id 的形式一致
+func testKeys(n int) []string {
+	rnd := rand.New(rand.NewSource(1))
+	keys := make([]string, n)
+	for i := range keys {
+		keys[i] = fmt.Sprintf("%08x-%04x-%04x-%04x-%012x",
+			rnd.Uint32(), rnd.Intn(1<<16), rnd.Intn(1<<16), rnd.Intn(1<<16), rnd.Int63n(1<<48))
+	}
+	return keys
+}
+
+func TestModMatchesLegacy(t *testing.T) {
+	r := MustNew(DefaultConfig())
+	for _, key := range testKeys(10000) {
+		if got, want := r.Table(key), legacyTable(key); got != want {
+			t.Fatalf("Table(%q) = %s, want %s", key, got, want)
+		}
+	}
+}
+
+func TestStrategiesInRange(t *testing.T) {
+	for _, strategy := range []Strategy{StrategyMod, StrategyRing, StrategyRange} {
+		t.Run(string(strategy), func(t *testing.T) {
+			r := MustNew(Config{Strategy: strategy, Shards: 8})
+			counts := make([]int, r.Shards())
+			for _, key := range testKeys(8000) {
+				i := r.Index(key)
+				if i < 0 || i >= r.Shards() {
+					t.Fatalf("Index(%q) = %d, out of [0, %d)", key, i, r.Shards())
+				}
+				if r.Index(key) != i {
+					t.Fatalf("Index(%q) is not stable", key)
+				}
+				counts[i]++
+			}
+			// 每个分片都分到 key, 且不过度倾斜
+			for i, n := range counts {
+				if n < 1000/4 || n > 1000*4 {
+					t.Fatalf("shard %d got %d of 8000 keys: %v", i, n, counts)
+				}
+			}
+		})
+	}
+}
+
+func TestRingMovesFewKeys(t *testing.T) {
+	before := MustNew(Config{Strategy: StrategyRing, Shards: 4})
+	after := MustNew(Config{Strategy: StrategyRing, Shards: 5})
+	keys := testKeys(10000)
+	moved := 0
+	for _, key := range keys {
+		i, j := before.Index(key), after.Index(key)
+		if i != j {
+			// 增加分片时 key 只会迁移到新分片
+			if j != 4 {
+				t.Fatalf("key %q moved from shard %d to old shard %d", key, i, j)
+			}
+			moved++
+		}
+	}
+	// 理想情况下迁移 1/5, 虚拟节点带来的偏差留出余量
+	if moved > len(keys)*2/5 {
+		t.Fatalf("moved %d of %d keys, want about %d", moved, len(keys), len(keys)/5)
+	}
+}
+
+func TestRangeBounds(t *testing.T) {
+	r, err := New(Config{Strategy: StrategyRange, Shards: 3, Ranges: []uint64{100, 200}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	p := r.picker.(*rangePicker)
+	for hash, want := range map[uint64]int{0: 0, 99: 0, 100: 1, 199: 1, 200: 2, ^uint64(0): 2} {
+		if got := p.pick(hash); got != want {
+			t.Fatalf("pick(%d) = %d, want %d", hash, got, want)
+		}
+	}
+
+	if _, err := New(Config{Strategy: StrategyRange, Shards: 3, Ranges: []uint64{100}}); err == nil {
+		t.Fatal("New() with too few bounds, want an error")
+	}
+	if _, err := New(Config{Strategy: StrategyRange, Shards: 3, Ranges: []uint64{200, 100}}); err == nil {
+		t.Fatal("New() with descending bounds, want an error")
+	}
+}
+
+func TestUnknownStrategy(t *testing.T) {
+	if _, err := New(Config{Strategy: "hash"}); err == nil {
+		t.Fatal("New() with an unknown strategy, want an error")
+	}
+}
+
+func TestTables(t *testing.T) {
+	r := MustNew(Config{Shards: 2, Table: "t_%d"})
+	tables := r.Tables()
+	if len(tables) != 2 || tables[0] != "t_0" || tables[1] != "t_1" || r.TableAt(1) != "t_1" {
+		t.Fatalf("Tables() = %v", tables)
+	}
+}
+
+func TestVersion(t *testing.T) {
+	// 配置文件中写死了默认配置的版本, 计算方式变化会导致所有服务启动失败
+	if v := MustNew(DefaultConfig()).Version(); v != "mod-4-aee9cadd" {
+		t.Fatalf("DefaultConfig() version = %s, want mod-4-aee9cadd", v)
+	}
+	// 补全默认值后相同的配置版本相同, 影响路由的配置项变化时版本变化
+	if MustNew(Config{}).Version() != MustNew(DefaultConfig()).Version() {
+		t.Fatal("empty config and DefaultConfig() have different versions")
+	}
+	versions := map[string]Config{}
+	for _, cfg := range []Config{
+		DefaultConfig(),
+		{Shards: 8},
+		{Table: "t_%d"},
+		{Strategy: StrategyRing},
+		{Strategy: StrategyRing, Replicas: 32},
+		{Strategy: StrategyRange},
+		{Strategy: StrategyRange, Ranges: []uint64{1, 2, 3}},
+	} {
+		v := MustNew(cfg).Version()
+		if prev, ok := versions[v]; ok {
+			t.Fatalf("%+v and %+v have the same version %s", prev, cfg, v)
+		}
+		versions[v] = cfg
+	}
+}
+
+func TestConfigVersion(t *testing.T) {
+	cfg := Config{Strategy: StrategyRing, Shards: 8}
+	cfg.Version = MustNew(cfg).Version()
+	r, err := New(cfg)
+	if err != nil {
+		t.Fatalf("New() with the computed version error = %v", err)
+	}
+	if r.Config().Version != cfg.Version {
+		t.Fatalf("Config().Version = %s, want %s", r.Config().Version, cfg.Version)
+	}
+
+	cfg.Shards = 16
+	if _, err := New(cfg); !errors.Is(err, ErrTopologyMismatch) {
+		t.Fatalf("New() with a stale version error = %v, want ErrTopologyMismatch", err)
+	}
+}
+
+func TestCheck(t *testing.T) {
+	r := MustNew(DefaultConfig())
+	if err := r.Check(r.Version()); err != nil {
+		t.Fatalf("Check() error = %v", err)
+	}
+	remote := MustNew(Config{Shards: 8})
+	if err := r.Check(remote.Version()); !errors.Is(err, ErrTopologyMismatch) {
+		t.Fatalf("Check() error = %v, want ErrTopologyMismatch", err)
+	}
+	if err := r.Check(""); !errors.Is(err, ErrTopologyMismatch) {
+		t.Fatalf("Check() with an empty version error = %v, want ErrTopologyMismatch", err)
+	}
+}
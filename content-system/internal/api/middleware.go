@@ -26,15 +26,29 @@ func (s *SessionAuth) Auth(c *gin.Context) {
 	// imp auth
 	if sessionID == "" {
 		c.AbortWithStatusJSON(http.StatusUnauthorized, "session id is null")
+		return
 	}
 	authKey := utils.GetAuthKey(sessionID)
 	loginTime, err := s.rdb.Get(c, authKey).Result()
 	if err != nil && err != redis.Nil {
 		c.AbortWithStatusJSON(http.StatusInternalServerError, "session auth error")
+		return
 	}
 	if loginTime == "" {
 		c.AbortWithStatusJSON(http.StatusUnauthorized, "session auth failed")
+		return
 	}
+	// 修改人等身份信息以会话中的用户为准, 不信任请求参数; 没有用户名的旧会话需要重新登录
+	username, err := s.rdb.Get(c, utils.GetSessionUserKey(sessionID)).Result()
+	if err != nil && err != redis.Nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, "session auth error")
+		return
+	}
+	if username == "" {
+		c.AbortWithStatusJSON(http.StatusUnauthorized, "session auth failed")
+		return
+	}
+	utils.SetUser(c, username)
 	c.Next()
 }
 
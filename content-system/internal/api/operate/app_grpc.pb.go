@@ -19,10 +19,23 @@ import (
 const _ = grpc.SupportPackageIsVersion8
 
 const (
-	App_CreateContent_FullMethodName = "/api.operate.App/CreateContent"
-	App_UpdateContent_FullMethodName = "/api.operate.App/UpdateContent"
-	App_DeleteContent_FullMethodName = "/api.operate.App/DeleteContent"
-	App_FindContent_FullMethodName   = "/api.operate.App/FindContent"
+	App_CreateContent_FullMethodName      = "/api.operate.App/CreateContent"
+	App_UpdateContent_FullMethodName      = "/api.operate.App/UpdateContent"
+	App_DeleteContent_FullMethodName      = "/api.operate.App/DeleteContent"
+	App_GetContent_FullMethodName         = "/api.operate.App/GetContent"
+	App_BatchCreateContent_FullMethodName = "/api.operate.App/BatchCreateContent"
+	App_BatchGetContent_FullMethodName    = "/api.operate.App/BatchGetContent"
+	App_BatchDeleteContent_FullMethodName = "/api.operate.App/BatchDeleteContent"
+	App_WatchContent_FullMethodName       = "/api.operate.App/WatchContent"
+	App_FindContent_FullMethodName        = "/api.operate.App/FindContent"
+	App_SearchContent_FullMethodName      = "/api.operate.App/SearchContent"
+	App_ListDeletedContent_FullMethodName = "/api.operate.App/ListDeletedContent"
+	App_RestoreContent_FullMethodName     = "/api.operate.App/RestoreContent"
+	App_PurgeContent_FullMethodName       = "/api.operate.App/PurgeContent"
+	App_ListRevisions_FullMethodName      = "/api.operate.App/ListRevisions"
+	App_GetRevision_FullMethodName        = "/api.operate.App/GetRevision"
+	App_DiffRevisions_FullMethodName      = "/api.operate.App/DiffRevisions"
+	App_RollbackContent_FullMethodName    = "/api.operate.App/RollbackContent"
 )
 
 // AppClient is the client API for App service.
@@ -35,8 +48,34 @@ type AppClient interface {
 	UpdateContent(ctx context.Context, in *UpdateContentReq, opts ...grpc.CallOption) (*UpdateContentRsp, error)
 	// 删除内容
 	DeleteContent(ctx context.Context, in *DeleteContentReq, opts ...grpc.CallOption) (*DeleteContentRsp, error)
+	// 按 id 或 content_id 查询内容
+	GetContent(ctx context.Context, in *GetContentReq, opts ...grpc.CallOption) (*GetContentRsp, error)
+	// 批量创建内容, 按分片表分组写入
+	BatchCreateContent(ctx context.Context, in *BatchCreateContentReq, opts ...grpc.CallOption) (*BatchCreateContentRsp, error)
+	// 按 id 批量查询内容
+	BatchGetContent(ctx context.Context, in *BatchGetContentReq, opts ...grpc.CallOption) (*BatchGetContentRsp, error)
+	// 批量删除内容
+	BatchDeleteContent(ctx context.Context, in *BatchDeleteContentReq, opts ...grpc.CallOption) (*BatchDeleteContentRsp, error)
+	// 订阅内容变更, 事务提交后推送
+	WatchContent(ctx context.Context, in *WatchContentReq, opts ...grpc.CallOption) (App_WatchContentClient, error)
 	// 内容查找
 	FindContent(ctx context.Context, in *FindContentReq, opts ...grpc.CallOption) (*FindContentRsp, error)
+	// 全文搜索标题和描述, 按相关度排序
+	SearchContent(ctx context.Context, in *SearchContentReq, opts ...grpc.CallOption) (*SearchContentRsp, error)
+	// 回收站列表, 按删除时间倒序
+	ListDeletedContent(ctx context.Context, in *ListDeletedContentReq, opts ...grpc.CallOption) (*ListDeletedContentRsp, error)
+	// 从回收站恢复内容
+	RestoreContent(ctx context.Context, in *RestoreContentReq, opts ...grpc.CallOption) (*RestoreContentRsp, error)
+	// 彻底删除回收站中的内容
+	PurgeContent(ctx context.Context, in *PurgeContentReq, opts ...grpc.CallOption) (*PurgeContentRsp, error)
+	// 内容的历史版本列表, 按版本号倒序
+	ListRevisions(ctx context.Context, in *ListRevisionsReq, opts ...grpc.CallOption) (*ListRevisionsRsp, error)
+	// 查询历史版本的完整内容
+	GetRevision(ctx context.Context, in *GetRevisionReq, opts ...grpc.CallOption) (*GetRevisionRsp, error)
+	// 比较两个历史版本
+	DiffRevisions(ctx context.Context, in *DiffRevisionsReq, opts ...grpc.CallOption) (*DiffRevisionsRsp, error)
+	// 将内容回滚到历史版本, 回滚本身记录为新版本
+	RollbackContent(ctx context.Context, in *RollbackContentReq, opts ...grpc.CallOption) (*RollbackContentRsp, error)
 }
 
 type appClient struct {
@@ -77,6 +116,79 @@ func (c *appClient) DeleteContent(ctx context.Context, in *DeleteContentReq, opt
 	return out, nil
 }
 
+func (c *appClient) GetContent(ctx context.Context, in *GetContentReq, opts ...grpc.CallOption) (*GetContentRsp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetContentRsp)
+	err := c.cc.Invoke(ctx, App_GetContent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appClient) BatchCreateContent(ctx context.Context, in *BatchCreateContentReq, opts ...grpc.CallOption) (*BatchCreateContentRsp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchCreateContentRsp)
+	err := c.cc.Invoke(ctx, App_BatchCreateContent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appClient) BatchGetContent(ctx context.Context, in *BatchGetContentReq, opts ...grpc.CallOption) (*BatchGetContentRsp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchGetContentRsp)
+	err := c.cc.Invoke(ctx, App_BatchGetContent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appClient) BatchDeleteContent(ctx context.Context, in *BatchDeleteContentReq, opts ...grpc.CallOption) (*BatchDeleteContentRsp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchDeleteContentRsp)
+	err := c.cc.Invoke(ctx, App_BatchDeleteContent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appClient) WatchContent(ctx context.Context, in *WatchContentReq, opts ...grpc.CallOption) (App_WatchContentClient, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &App_ServiceDesc.Streams[0], App_WatchContent_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &appWatchContentClient{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type App_WatchContentClient interface {
+	Recv() (*WatchContentRsp, error)
+	grpc.ClientStream
+}
+
+type appWatchContentClient struct {
+	grpc.ClientStream
+}
+
+func (x *appWatchContentClient) Recv() (*WatchContentRsp, error) {
+	m := new(WatchContentRsp)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *appClient) FindContent(ctx context.Context, in *FindContentReq, opts ...grpc.CallOption) (*FindContentRsp, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(FindContentRsp)
@@ -87,6 +199,86 @@ func (c *appClient) FindContent(ctx context.Context, in *FindContentReq, opts ..
 	return out, nil
 }
 
+func (c *appClient) SearchContent(ctx context.Context, in *SearchContentReq, opts ...grpc.CallOption) (*SearchContentRsp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchContentRsp)
+	err := c.cc.Invoke(ctx, App_SearchContent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appClient) ListDeletedContent(ctx context.Context, in *ListDeletedContentReq, opts ...grpc.CallOption) (*ListDeletedContentRsp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDeletedContentRsp)
+	err := c.cc.Invoke(ctx, App_ListDeletedContent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appClient) RestoreContent(ctx context.Context, in *RestoreContentReq, opts ...grpc.CallOption) (*RestoreContentRsp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestoreContentRsp)
+	err := c.cc.Invoke(ctx, App_RestoreContent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appClient) PurgeContent(ctx context.Context, in *PurgeContentReq, opts ...grpc.CallOption) (*PurgeContentRsp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PurgeContentRsp)
+	err := c.cc.Invoke(ctx, App_PurgeContent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appClient) ListRevisions(ctx context.Context, in *ListRevisionsReq, opts ...grpc.CallOption) (*ListRevisionsRsp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListRevisionsRsp)
+	err := c.cc.Invoke(ctx, App_ListRevisions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appClient) GetRevision(ctx context.Context, in *GetRevisionReq, opts ...grpc.CallOption) (*GetRevisionRsp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRevisionRsp)
+	err := c.cc.Invoke(ctx, App_GetRevision_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appClient) DiffRevisions(ctx context.Context, in *DiffRevisionsReq, opts ...grpc.CallOption) (*DiffRevisionsRsp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DiffRevisionsRsp)
+	err := c.cc.Invoke(ctx, App_DiffRevisions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appClient) RollbackContent(ctx context.Context, in *RollbackContentReq, opts ...grpc.CallOption) (*RollbackContentRsp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RollbackContentRsp)
+	err := c.cc.Invoke(ctx, App_RollbackContent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AppServer is the server API for App service.
 // All implementations must embed UnimplementedAppServer
 // for forward compatibility
@@ -97,8 +289,34 @@ type AppServer interface {
 	UpdateContent(context.Context, *UpdateContentReq) (*UpdateContentRsp, error)
 	// 删除内容
 	DeleteContent(context.Context, *DeleteContentReq) (*DeleteContentRsp, error)
+	// 按 id 或 content_id 查询内容
+	GetContent(context.Context, *GetContentReq) (*GetContentRsp, error)
+	// 批量创建内容, 按分片表分组写入
+	BatchCreateContent(context.Context, *BatchCreateContentReq) (*BatchCreateContentRsp, error)
+	// 按 id 批量查询内容
+	BatchGetContent(context.Context, *BatchGetContentReq) (*BatchGetContentRsp, error)
+	// 批量删除内容
+	BatchDeleteContent(context.Context, *BatchDeleteContentReq) (*BatchDeleteContentRsp, error)
+	// 订阅内容变更, 事务提交后推送
+	WatchContent(*WatchContentReq, App_WatchContentServer) error
 	// 内容查找
 	FindContent(context.Context, *FindContentReq) (*FindContentRsp, error)
+	// 全文搜索标题和描述, 按相关度排序
+	SearchContent(context.Context, *SearchContentReq) (*SearchContentRsp, error)
+	// 回收站列表, 按删除时间倒序
+	ListDeletedContent(context.Context, *ListDeletedContentReq) (*ListDeletedContentRsp, error)
+	// 从回收站恢复内容
+	RestoreContent(context.Context, *RestoreContentReq) (*RestoreContentRsp, error)
+	// 彻底删除回收站中的内容
+	PurgeContent(context.Context, *PurgeContentReq) (*PurgeContentRsp, error)
+	// 内容的历史版本列表, 按版本号倒序
+	ListRevisions(context.Context, *ListRevisionsReq) (*ListRevisionsRsp, error)
+	// 查询历史版本的完整内容
+	GetRevision(context.Context, *GetRevisionReq) (*GetRevisionRsp, error)
+	// 比较两个历史版本
+	DiffRevisions(context.Context, *DiffRevisionsReq) (*DiffRevisionsRsp, error)
+	// 将内容回滚到历史版本, 回滚本身记录为新版本
+	RollbackContent(context.Context, *RollbackContentReq) (*RollbackContentRsp, error)
 	mustEmbedUnimplementedAppServer()
 }
 
@@ -115,9 +333,48 @@ func (UnimplementedAppServer) UpdateContent(context.Context, *UpdateContentReq)
 func (UnimplementedAppServer) DeleteContent(context.Context, *DeleteContentReq) (*DeleteContentRsp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteContent not implemented")
 }
+func (UnimplementedAppServer) GetContent(context.Context, *GetContentReq) (*GetContentRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetContent not implemented")
+}
+func (UnimplementedAppServer) BatchCreateContent(context.Context, *BatchCreateContentReq) (*BatchCreateContentRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchCreateContent not implemented")
+}
+func (UnimplementedAppServer) BatchGetContent(context.Context, *BatchGetContentReq) (*BatchGetContentRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchGetContent not implemented")
+}
+func (UnimplementedAppServer) BatchDeleteContent(context.Context, *BatchDeleteContentReq) (*BatchDeleteContentRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchDeleteContent not implemented")
+}
+func (UnimplementedAppServer) WatchContent(*WatchContentReq, App_WatchContentServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchContent not implemented")
+}
 func (UnimplementedAppServer) FindContent(context.Context, *FindContentReq) (*FindContentRsp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FindContent not implemented")
 }
+func (UnimplementedAppServer) SearchContent(context.Context, *SearchContentReq) (*SearchContentRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchContent not implemented")
+}
+func (UnimplementedAppServer) ListDeletedContent(context.Context, *ListDeletedContentReq) (*ListDeletedContentRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDeletedContent not implemented")
+}
+func (UnimplementedAppServer) RestoreContent(context.Context, *RestoreContentReq) (*RestoreContentRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreContent not implemented")
+}
+func (UnimplementedAppServer) PurgeContent(context.Context, *PurgeContentReq) (*PurgeContentRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurgeContent not implemented")
+}
+func (UnimplementedAppServer) ListRevisions(context.Context, *ListRevisionsReq) (*ListRevisionsRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRevisions not implemented")
+}
+func (UnimplementedAppServer) GetRevision(context.Context, *GetRevisionReq) (*GetRevisionRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRevision not implemented")
+}
+func (UnimplementedAppServer) DiffRevisions(context.Context, *DiffRevisionsReq) (*DiffRevisionsRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiffRevisions not implemented")
+}
+func (UnimplementedAppServer) RollbackContent(context.Context, *RollbackContentReq) (*RollbackContentRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RollbackContent not implemented")
+}
 func (UnimplementedAppServer) mustEmbedUnimplementedAppServer() {}
 
 // UnsafeAppServer may be embedded to opt out of forward compatibility for this service.
@@ -185,6 +442,99 @@ func _App_DeleteContent_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _App_GetContent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetContentReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).GetContent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: App_GetContent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).GetContent(ctx, req.(*GetContentReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _App_BatchCreateContent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchCreateContentReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).BatchCreateContent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: App_BatchCreateContent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).BatchCreateContent(ctx, req.(*BatchCreateContentReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _App_BatchGetContent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchGetContentReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).BatchGetContent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: App_BatchGetContent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).BatchGetContent(ctx, req.(*BatchGetContentReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _App_BatchDeleteContent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchDeleteContentReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).BatchDeleteContent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: App_BatchDeleteContent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).BatchDeleteContent(ctx, req.(*BatchDeleteContentReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _App_WatchContent_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchContentReq)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(AppServer).WatchContent(m, &appWatchContentServer{ServerStream: stream})
+}
+
+type App_WatchContentServer interface {
+	Send(*WatchContentRsp) error
+	grpc.ServerStream
+}
+
+type appWatchContentServer struct {
+	grpc.ServerStream
+}
+
+func (x *appWatchContentServer) Send(m *WatchContentRsp) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _App_FindContent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(FindContentReq)
 	if err := dec(in); err != nil {
@@ -203,6 +553,150 @@ func _App_FindContent_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _App_SearchContent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchContentReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).SearchContent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: App_SearchContent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).SearchContent(ctx, req.(*SearchContentReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _App_ListDeletedContent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDeletedContentReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).ListDeletedContent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: App_ListDeletedContent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).ListDeletedContent(ctx, req.(*ListDeletedContentReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _App_RestoreContent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreContentReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).RestoreContent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: App_RestoreContent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).RestoreContent(ctx, req.(*RestoreContentReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _App_PurgeContent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurgeContentReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).PurgeContent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: App_PurgeContent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).PurgeContent(ctx, req.(*PurgeContentReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _App_ListRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRevisionsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).ListRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: App_ListRevisions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).ListRevisions(ctx, req.(*ListRevisionsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _App_GetRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRevisionReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).GetRevision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: App_GetRevision_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).GetRevision(ctx, req.(*GetRevisionReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _App_DiffRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiffRevisionsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).DiffRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: App_DiffRevisions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).DiffRevisions(ctx, req.(*DiffRevisionsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _App_RollbackContent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RollbackContentReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).RollbackContent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: App_RollbackContent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).RollbackContent(ctx, req.(*RollbackContentReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // App_ServiceDesc is the grpc.ServiceDesc for App service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -222,11 +716,65 @@ var App_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteContent",
 			Handler:    _App_DeleteContent_Handler,
 		},
+		{
+			MethodName: "GetContent",
+			Handler:    _App_GetContent_Handler,
+		},
+		{
+			MethodName: "BatchCreateContent",
+			Handler:    _App_BatchCreateContent_Handler,
+		},
+		{
+			MethodName: "BatchGetContent",
+			Handler:    _App_BatchGetContent_Handler,
+		},
+		{
+			MethodName: "BatchDeleteContent",
+			Handler:    _App_BatchDeleteContent_Handler,
+		},
 		{
 			MethodName: "FindContent",
 			Handler:    _App_FindContent_Handler,
 		},
+		{
+			MethodName: "SearchContent",
+			Handler:    _App_SearchContent_Handler,
+		},
+		{
+			MethodName: "ListDeletedContent",
+			Handler:    _App_ListDeletedContent_Handler,
+		},
+		{
+			MethodName: "RestoreContent",
+			Handler:    _App_RestoreContent_Handler,
+		},
+		{
+			MethodName: "PurgeContent",
+			Handler:    _App_PurgeContent_Handler,
+		},
+		{
+			MethodName: "ListRevisions",
+			Handler:    _App_ListRevisions_Handler,
+		},
+		{
+			MethodName: "GetRevision",
+			Handler:    _App_GetRevision_Handler,
+		},
+		{
+			MethodName: "DiffRevisions",
+			Handler:    _App_DiffRevisions_Handler,
+		},
+		{
+			MethodName: "RollbackContent",
+			Handler:    _App_RollbackContent_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchContent",
+			Handler:       _App_WatchContent_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/operate/app.proto",
 }
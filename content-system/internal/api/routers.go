@@ -23,11 +23,37 @@ func CmsRouters(r *gin.Engine) {
 		// /api/cms/content/create
 		root.POST("/cms/content/create", cmsApp.ContentCreate)
 		// /api/cms/content/update
-		root.POST("/cms/content/update", cmsApp.ContentUpdate)
+		root.PATCH("/cms/content/update", cmsApp.ContentUpdate)
 		// /api/cms/content/delete
 		root.POST("/cms/content/delete", cmsApp.ContentDelete)
+		// /api/cms/content/get
+		root.GET("/cms/content/get", cmsApp.ContentGet)
+		// /api/cms/content/batch/create
+		root.POST("/cms/content/batch/create", cmsApp.ContentBatchCreate)
+		// /api/cms/content/batch/get
+		root.GET("/cms/content/batch/get", cmsApp.ContentBatchGet)
+		// /api/cms/content/batch/delete
+		root.POST("/cms/content/batch/delete", cmsApp.ContentBatchDelete)
+		// /api/cms/content/watch
+		root.GET("/cms/content/watch", cmsApp.ContentWatch)
 		// /api/cms/content/find
 		root.GET("/cms/content/find", cmsApp.ContentFind)
+		// /api/cms/content/search
+		root.GET("/cms/content/search", cmsApp.ContentSearch)
+		// /api/cms/content/trash
+		root.GET("/cms/content/trash", cmsApp.ContentTrash)
+		// /api/cms/content/restore
+		root.POST("/cms/content/restore", cmsApp.ContentRestore)
+		// /api/cms/content/purge
+		root.POST("/cms/content/purge", cmsApp.ContentPurge)
+		// /api/cms/content/revisions
+		root.GET("/cms/content/revisions", cmsApp.ContentRevisions)
+		// /api/cms/content/revision
+		root.GET("/cms/content/revision", cmsApp.ContentRevision)
+		// /api/cms/content/revision/diff
+		root.GET("/cms/content/revision/diff", cmsApp.ContentRevisionDiff)
+		// /api/cms/content/rollback
+		root.POST("/cms/content/rollback", cmsApp.ContentRollback)
 	}
 
 	noAuth := r.Group(noAuthPath)
@@ -0,0 +1,100 @@
+package services
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/zerokkcoder/content-system/internal/api/operate"
+)
+
+type ContentBatchCreateReq struct {
+	Contents []*ContentCreateReq `json:"contents" binding:"required,min=1,max=500,dive"`
+	// 为 true 时任意一条失败则全部不写入
+	Atomic bool `json:"atomic"`
+}
+
+type ContentBatchGetReq struct {
+	IDs []int64 `json:"ids" binding:"required,min=1,max=500"`
+}
+
+type ContentBatchDeleteReq struct {
+	IDs []int64 `json:"ids" binding:"required,min=1,max=500"`
+	// 为 true 时任意一条失败则全部不删除
+	Atomic bool `json:"atomic"`
+}
+
+func (ca *CmsApp) ContentBatchCreate(c *gin.Context) {
+	var req ContentBatchCreateReq
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	contents := make([]*operate.Content, 0, len(req.Contents))
+	for _, content := range req.Contents {
+		contents = append(contents, content.toContent())
+	}
+	rsp, err := ca.operationAppClient.BatchCreateContent(c, &operate.BatchCreateContentReq{
+		Contents: contents,
+		Atomic:   req.Atomic,
+	})
+	if err != nil {
+		rpcError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"code": 0,
+		"msg":  "ok",
+		"data": rsp,
+	})
+}
+
+func (ca *CmsApp) ContentBatchGet(c *gin.Context) {
+	var req ContentBatchGetReq
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	rsp, err := ca.operationAppClient.BatchGetContent(c, &operate.BatchGetContentReq{Ids: req.IDs})
+	if err != nil {
+		rpcError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"code": 0,
+		"msg":  "ok",
+		"data": rsp,
+	})
+}
+
+func (ca *CmsApp) ContentBatchDelete(c *gin.Context) {
+	var req ContentBatchDeleteReq
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	rsp, err := ca.operationAppClient.BatchDeleteContent(c, &operate.BatchDeleteContentReq{
+		Ids:    req.IDs,
+		Atomic: req.Atomic,
+	})
+	if err != nil {
+		rpcError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"code": 0,
+		"msg":  "ok",
+		"data": rsp,
+	})
+}
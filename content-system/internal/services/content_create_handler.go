@@ -6,6 +6,7 @@ import (
 
 	"github.com/gin-gonic/gin"
 	"github.com/zerokkcoder/content-system/internal/api/operate"
+	"google.golang.org/protobuf/types/known/durationpb"
 )
 
 type ContentCreateReq struct {
@@ -15,7 +16,7 @@ type ContentCreateReq struct {
 	Description    string        `json:"description"`
 	Thumbnail      string        `json:"thumbnail"`
 	Category       string        `json:"category"`
-	Duration       time.Duration `json:"duration"`
+	Duration       time.Duration `json:"duration"` // 单位纳秒, 与 time.Duration 一致
 	Resolution     string        `json:"resolution"`
 	FileSize       int64         `json:"file_size"`
 	Format         string        `json:"format"`
@@ -40,44 +41,34 @@ func (ca *CmsApp) ContentCreate(c *gin.Context) {
 
 	// 远程调用
 	rsp, err := ca.operationAppClient.CreateContent(c, &operate.CreateContentReq{
-		Content: &operate.Content{
-			Title:          req.Title,
-			Description:    req.Description,
-			Author:         req.Author,
-			VideoUrl:       req.VideoURL,
-			Thumbnail:      req.Thumbnail,
-			Category:       req.Category,
-			Duration:       req.Duration.Microseconds(),
-			Resolution:     req.Resolution,
-			FileSize:       req.FileSize,
-			Format:         req.Format,
-			Quality:        req.Quality,
-			ApprovalStatus: req.ApprovalStatus,
-		},
+		Content: req.toContent(),
+		// 超时重试时带上相同的 Idempotency-Key, 不会重复创建
+		IdempotencyKey: c.GetHeader("Idempotency-Key"),
 	})
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{
-			"error": err.Error(),
-		})
+		rpcError(c, err)
 		return
 	}
-	// 工作流
-	// flowData := map[string]interface{}{
-	// 	"content_id": id,
-	// }
-	// data, _ := json.Marshal(flowData)
-	// if err := ca.flowService.Execute("content-flow", &goflow.Request{
-	// 	Body: data,
-	// }); err != nil {
-	// 	c.JSON(http.StatusBadRequest, gin.H{
-	// 		"error": err.Error(),
-	// 	})
-	// 	return
-	// }
-
 	c.JSON(http.StatusOK, gin.H{
 		"code": 0,
 		"msg":  "ok",
 		"data": rsp,
 	})
 }
+
+func (req *ContentCreateReq) toContent() *operate.Content {
+	return &operate.Content{
+		Title:          req.Title,
+		Description:    req.Description,
+		Author:         req.Author,
+		VideoUrl:       req.VideoURL,
+		Thumbnail:      req.Thumbnail,
+		Category:       req.Category,
+		Duration:       durationpb.New(req.Duration),
+		Resolution:     req.Resolution,
+		FileSize:       req.FileSize,
+		Format:         req.Format,
+		Quality:        req.Quality,
+		ApprovalStatus: req.ApprovalStatus,
+	}
+}
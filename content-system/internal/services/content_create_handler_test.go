@@ -0,0 +1,31 @@
+package services
+
+import (
+	"encoding/json"
+	"os"
+	"testing"
+
+	"github.com/zerokkcoder/content-system/internal/api/operate"
+	"google.golang.org/protobuf/encoding/prototext"
+	"google.golang.org/protobuf/proto"
+)
+
+// TestContentCreateContract 创建请求转换后与 content-manage 测试写入的请求一致
+func TestContentCreateContract(t *testing.T) {
+	body := `{"title":"golang","video_url":"https://example.com/golang.mp4","author":"gopher","duration":5400000000001}`
+	var req ContentCreateReq
+	if err := json.Unmarshal([]byte(body), &req); err != nil {
+		t.Fatal(err)
+	}
+	golden, err := os.ReadFile("../../../content-manage/internal/data/testdata/create_content.txtpb")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var want operate.CreateContentReq
+	if err := prototext.Unmarshal(golden, &want); err != nil {
+		t.Fatal(err)
+	}
+	if got := (&operate.CreateContentReq{Content: req.toContent()}); !proto.Equal(got, &want) {
+		t.Fatalf("CreateContentReq = %v, want %v", got, &want)
+	}
+}
@@ -9,6 +9,8 @@ import (
 
 type ContentDeleteReq struct {
 	ID int64 `json:"id" binding:"required"`
+	// 期望的版本号, 也可以通过 If-Match 请求头传入
+	ExpectedVersion int64 `json:"expected_version"`
 }
 
 type ContentDeleteRsp struct {
@@ -24,14 +26,23 @@ func (ca *CmsApp) ContentDelete(c *gin.Context) {
 		return
 	}
 
-	rsp, err := ca.operationAppClient.DeleteContent(c, &operate.DeleteContentReq{Id: req.ID})
+	version, err := expectedVersion(c, req.ExpectedVersion)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{
-			"error": err.Error(),
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "invalid If-Match header",
 		})
 		return
 	}
 
+	rsp, err := ca.operationAppClient.DeleteContent(c, &operate.DeleteContentReq{
+		Id:              req.ID,
+		ExpectedVersion: version,
+	})
+	if err != nil {
+		rpcError(c, err)
+		return
+	}
+
 	c.JSON(http.StatusOK, gin.H{
 		"code": 0,
 		"msg":  "ok",
@@ -0,0 +1,33 @@
+package services
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/zerokkcoder/content-system/internal/api/operate"
+)
+
+// rpcError 按 content-manage 返回的错误设置状态码, 如内容不存在返回 404, 分片不可用返回 503
+func rpcError(c *gin.Context, err error) {
+	e := errors.FromError(err)
+	status := int(e.Code)
+	if status < http.StatusBadRequest || status > 599 {
+		status = http.StatusInternalServerError
+	}
+	c.JSON(status, rpcErrorBody(e))
+}
+
+// rpcErrorBody reason 为 ErrorReason 中的机器可读错误码, metadata 中为参数校验失败的字段等详情
+func rpcErrorBody(e *errors.Error) gin.H {
+	reason := e.Reason
+	if reason == "" {
+		reason = operate.ErrorReason_ERROR_REASON_UNSPECIFIED.String()
+	}
+	return gin.H{
+		"code":     e.Code,
+		"reason":   reason,
+		"error":    e.Message,
+		"metadata": e.Metadata,
+	}
+}
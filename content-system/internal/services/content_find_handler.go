@@ -2,17 +2,42 @@ package services
 
 import (
 	"net/http"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/zerokkcoder/content-system/internal/api/operate"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type ContentFindReq struct {
-	ID       int64  `json:"id"`
-	Author   string `json:"author"`
-	Title    string `json:"title"`
-	Page     int32  `json:"page"`
-	PageSize int32  `json:"page_size"`
+	ID             int64         `json:"id"`
+	Author         string        `json:"author"`
+	Title          string        `json:"title"`
+	Category       string        `json:"category"`
+	ApprovalStatus []int32       `json:"approval_status"`
+	Quality        int32         `json:"quality"`
+	Format         string        `json:"format"`
+	Resolution     string        `json:"resolution"`
+	CreatedAfter   *time.Time    `json:"created_after"`
+	CreatedBefore  *time.Time    `json:"created_before"`
+	UpdatedAfter   *time.Time    `json:"updated_after"`
+	UpdatedBefore  *time.Time    `json:"updated_before"`
+	MinDuration    time.Duration `json:"min_duration"` // 单位纳秒
+	MaxDuration    time.Duration `json:"max_duration"`
+	SortBy         string        `json:"sort_by" binding:"omitempty,oneof=created_at updated_at duration file_size"`
+	Order          string        `json:"order" binding:"omitempty,oneof=asc desc"`
+	Page           int32         `json:"page"`
+	PageSize       int32         `json:"page_size"`
+	PageToken      string        `json:"page_token"`
+	SkipTotal      bool          `json:"skip_total"`
+}
+
+var sortBy = map[string]operate.SortBy{
+	"created_at": operate.SortBy_SORT_BY_CREATED_AT,
+	"updated_at": operate.SortBy_SORT_BY_UPDATED_AT,
+	"duration":   operate.SortBy_SORT_BY_DURATION,
+	"file_size":  operate.SortBy_SORT_BY_FILE_SIZE,
 }
 
 func (ca *CmsApp) ContentFind(c *gin.Context) {
@@ -24,18 +49,35 @@ func (ca *CmsApp) ContentFind(c *gin.Context) {
 		return
 	}
 
+	order := operate.SortOrder_SORT_ORDER_ASC
+	if req.Order == "desc" {
+		order = operate.SortOrder_SORT_ORDER_DESC
+	}
 	// 访问操作服务
 	rsp, err := ca.operationAppClient.FindContent(c, &operate.FindContentReq{
-		Id:       req.ID,
-		Author:   req.Author,
-		Title:    req.Title,
-		Page:     req.Page,
-		PageSize: req.PageSize,
+		Id:             req.ID,
+		Author:         req.Author,
+		Title:          req.Title,
+		Category:       req.Category,
+		ApprovalStatus: req.ApprovalStatus,
+		Quality:        req.Quality,
+		Format:         req.Format,
+		Resolution:     req.Resolution,
+		CreatedAfter:   toTimestamp(req.CreatedAfter),
+		CreatedBefore:  toTimestamp(req.CreatedBefore),
+		UpdatedAfter:   toTimestamp(req.UpdatedAfter),
+		UpdatedBefore:  toTimestamp(req.UpdatedBefore),
+		MinDuration:    durationpb.New(req.MinDuration),
+		MaxDuration:    durationpb.New(req.MaxDuration),
+		SortBy:         sortBy[req.SortBy],
+		Order:          order,
+		Page:           req.Page,
+		PageSize:       req.PageSize,
+		PageToken:      req.PageToken,
+		SkipTotal:      req.SkipTotal,
 	})
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{
-			"error": err.Error(),
-		})
+		rpcError(c, err)
 		return
 	}
 
@@ -45,3 +87,10 @@ func (ca *CmsApp) ContentFind(c *gin.Context) {
 		"data": rsp,
 	})
 }
+
+func toTimestamp(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+	return timestamppb.New(*t)
+}
@@ -0,0 +1,48 @@
+package services
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/zerokkcoder/content-system/internal/api/operate"
+)
+
+// ContentGetReq 按 id 或 content_id 查询, 两者都传时以 id 为准
+type ContentGetReq struct {
+	ID        int64  `json:"id"`
+	ContentID string `json:"content_id"`
+}
+
+func (ca *CmsApp) ContentGet(c *gin.Context) {
+	var req ContentGetReq
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+	if req.ID == 0 && req.ContentID == "" {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "id or content_id is required",
+		})
+		return
+	}
+
+	rsp, err := ca.operationAppClient.GetContent(c, &operate.GetContentReq{
+		Id:        req.ID,
+		ContentId: req.ContentID,
+	})
+	if err != nil {
+		rpcError(c, err)
+		return
+	}
+
+	// 版本号作为 ETag, 更新和删除时可通过 If-Match 带回
+	c.Header("ETag", strconv.Quote(strconv.FormatInt(rsp.GetContent().GetVersion(), 10)))
+	c.JSON(http.StatusOK, gin.H{
+		"code": 0,
+		"msg":  "ok",
+		"data": rsp,
+	})
+}
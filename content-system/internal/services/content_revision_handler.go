@@ -0,0 +1,135 @@
+package services
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/zerokkcoder/content-system/internal/api/operate"
+	"github.com/zerokkcoder/content-system/internal/utils"
+)
+
+type ContentRevisionsReq struct {
+	ID       int64 `json:"id" binding:"required"`
+	Page     int32 `json:"page"`
+	PageSize int32 `json:"page_size"`
+}
+
+func (ca *CmsApp) ContentRevisions(c *gin.Context) {
+	var req ContentRevisionsReq
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	rsp, err := ca.operationAppClient.ListRevisions(c, &operate.ListRevisionsReq{
+		Id:       req.ID,
+		Page:     req.Page,
+		PageSize: req.PageSize,
+	})
+	if err != nil {
+		rpcError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"code": 0,
+		"msg":  "ok",
+		"data": rsp,
+	})
+}
+
+type ContentRevisionReq struct {
+	ID       int64 `json:"id" binding:"required"`
+	Revision int32 `json:"revision" binding:"required"`
+}
+
+func (ca *CmsApp) ContentRevision(c *gin.Context) {
+	var req ContentRevisionReq
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	rsp, err := ca.operationAppClient.GetRevision(c, &operate.GetRevisionReq{
+		Id:       req.ID,
+		Revision: req.Revision,
+	})
+	if err != nil {
+		rpcError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"code": 0,
+		"msg":  "ok",
+		"data": rsp,
+	})
+}
+
+type ContentRevisionDiffReq struct {
+	ID   int64 `json:"id" binding:"required"`
+	From int32 `json:"from" binding:"required"`
+	To   int32 `json:"to" binding:"required"`
+}
+
+func (ca *CmsApp) ContentRevisionDiff(c *gin.Context) {
+	var req ContentRevisionDiffReq
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	rsp, err := ca.operationAppClient.DiffRevisions(c, &operate.DiffRevisionsReq{
+		Id:   req.ID,
+		From: req.From,
+		To:   req.To,
+	})
+	if err != nil {
+		rpcError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"code": 0,
+		"msg":  "ok",
+		"data": rsp,
+	})
+}
+
+// ContentRollbackReq 修改人为当前登录的用户
+type ContentRollbackReq struct {
+	ID       int64 `json:"id" binding:"required"`
+	Revision int32 `json:"revision" binding:"required"`
+}
+
+func (ca *CmsApp) ContentRollback(c *gin.Context) {
+	var req ContentRollbackReq
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	rsp, err := ca.operationAppClient.RollbackContent(c, &operate.RollbackContentReq{
+		Id:       req.ID,
+		Revision: req.Revision,
+		Editor:   utils.GetUser(c),
+	})
+	if err != nil {
+		rpcError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"code": 0,
+		"msg":  "ok",
+		"data": rsp,
+	})
+}
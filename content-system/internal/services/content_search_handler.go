@@ -0,0 +1,41 @@
+package services
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/zerokkcoder/content-system/internal/api/operate"
+)
+
+type ContentSearchReq struct {
+	Query    string `json:"query" binding:"required"`
+	Page     int32  `json:"page"`
+	PageSize int32  `json:"page_size"`
+}
+
+func (ca *CmsApp) ContentSearch(c *gin.Context) {
+	var req ContentSearchReq
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	// 访问操作服务
+	rsp, err := ca.operationAppClient.SearchContent(c, &operate.SearchContentReq{
+		Query:    req.Query,
+		Page:     req.Page,
+		PageSize: req.PageSize,
+	})
+	if err != nil {
+		rpcError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"code": 0,
+		"msg":  "ok",
+		"data": rsp,
+	})
+}
@@ -0,0 +1,90 @@
+package services
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/zerokkcoder/content-system/internal/api/operate"
+)
+
+type ContentTrashReq struct {
+	Page     int32 `json:"page"`
+	PageSize int32 `json:"page_size"`
+}
+
+func (ca *CmsApp) ContentTrash(c *gin.Context) {
+	var req ContentTrashReq
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	rsp, err := ca.operationAppClient.ListDeletedContent(c, &operate.ListDeletedContentReq{
+		Page:     req.Page,
+		PageSize: req.PageSize,
+	})
+	if err != nil {
+		rpcError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"code": 0,
+		"msg":  "ok",
+		"data": rsp,
+	})
+}
+
+type ContentRestoreReq struct {
+	ID int64 `json:"id" binding:"required"`
+}
+
+func (ca *CmsApp) ContentRestore(c *gin.Context) {
+	var req ContentRestoreReq
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	rsp, err := ca.operationAppClient.RestoreContent(c, &operate.RestoreContentReq{Id: req.ID})
+	if err != nil {
+		rpcError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"code": 0,
+		"msg":  "ok",
+		"data": rsp,
+	})
+}
+
+type ContentPurgeReq struct {
+	ID int64 `json:"id" binding:"required"`
+}
+
+func (ca *CmsApp) ContentPurge(c *gin.Context) {
+	var req ContentPurgeReq
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	rsp, err := ca.operationAppClient.PurgeContent(c, &operate.PurgeContentReq{Id: req.ID})
+	if err != nil {
+		rpcError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"code": 0,
+		"msg":  "ok",
+		"data": rsp,
+	})
+}
@@ -6,22 +6,28 @@ import (
 
 	"github.com/gin-gonic/gin"
 	"github.com/zerokkcoder/content-system/internal/api/operate"
+	"github.com/zerokkcoder/content-system/internal/utils"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
 )
 
+// ContentUpdateReq 只更新请求中出现的字段, 传空值可清空字段; 修改人为当前登录的用户
 type ContentUpdateReq struct {
-	ID             int64         `json:"id" binding:"required"`
-	Title          string        `json:"title" binding:"required"`
-	VideoURL       string        `json:"video_url" binding:"required"`
-	Author         string        `json:"author" binding:"required"`
-	Description    string        `json:"description"`
-	Thumbnail      string        `json:"thumbnail"`
-	Category       string        `json:"category"`
-	Duration       time.Duration `json:"duration"`
-	Resolution     string        `json:"resolution"`
-	FileSize       int64         `json:"file_size"`
-	Format         string        `json:"format"`
-	Quality        int32         `json:"quality"`
-	ApprovalStatus int32         `json:"approval_status"`
+	ID             int64          `json:"id" binding:"required"`
+	Title          *string        `json:"title" binding:"omitempty,min=1"`
+	VideoURL       *string        `json:"video_url" binding:"omitempty,min=1"`
+	Author         *string        `json:"author" binding:"omitempty,min=1"`
+	Description    *string        `json:"description"`
+	Thumbnail      *string        `json:"thumbnail"`
+	Category       *string        `json:"category"`
+	Duration       *time.Duration `json:"duration"` // 单位纳秒
+	Resolution     *string        `json:"resolution"`
+	FileSize       *int64         `json:"file_size"`
+	Format         *string        `json:"format"`
+	Quality        *int32         `json:"quality"`
+	ApprovalStatus *int32         `json:"approval_status"`
+	// 期望的版本号, 也可以通过 If-Match 请求头传入
+	ExpectedVersion int64 `json:"expected_version"`
 }
 
 type ContentUpdateRsp struct {
@@ -37,29 +43,31 @@ func (ca *CmsApp) ContentUpdate(c *gin.Context) {
 		return
 	}
 
+	content, mask := req.toContent()
+	if len(mask.Paths) == 0 {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "no field to update",
+		})
+		return
+	}
+	version, err := expectedVersion(c, req.ExpectedVersion)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "invalid If-Match header",
+		})
+		return
+	}
+
 	// 远程调用
 	rsp, err := ca.operationAppClient.UpdateContent(c, &operate.UpdateContentReq{
-		Content: &operate.Content{
-			Id:             req.ID,
-			Title:          req.Title,
-			Description:    req.Description,
-			Author:         req.Author,
-			VideoUrl:       req.VideoURL,
-			Thumbnail:      req.Thumbnail,
-			Category:       req.Category,
-			Duration:       req.Duration.Microseconds(),
-			Resolution:     req.Resolution,
-			FileSize:       req.FileSize,
-			Format:         req.Format,
-			Quality:        req.Quality,
-			ApprovalStatus: req.ApprovalStatus,
-		},
+		Content:         content,
+		Editor:          utils.GetUser(c),
+		UpdateMask:      mask,
+		ExpectedVersion: version,
 	})
 
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{
-			"error": err.Error(),
-		})
+		rpcError(c, err)
 		return
 	}
 
@@ -69,3 +77,58 @@ func (ca *CmsApp) ContentUpdate(c *gin.Context) {
 		"data": rsp,
 	})
 }
+
+// toContent 将出现的字段写入 content 并记录到 update_mask
+func (req *ContentUpdateReq) toContent() (*operate.Content, *fieldmaskpb.FieldMask) {
+	content := &operate.Content{Id: req.ID}
+	mask := &fieldmaskpb.FieldMask{}
+	if req.Title != nil {
+		content.Title = *req.Title
+		mask.Paths = append(mask.Paths, "title")
+	}
+	if req.VideoURL != nil {
+		content.VideoUrl = *req.VideoURL
+		mask.Paths = append(mask.Paths, "video_url")
+	}
+	if req.Author != nil {
+		content.Author = *req.Author
+		mask.Paths = append(mask.Paths, "author")
+	}
+	if req.Description != nil {
+		content.Description = *req.Description
+		mask.Paths = append(mask.Paths, "description")
+	}
+	if req.Thumbnail != nil {
+		content.Thumbnail = *req.Thumbnail
+		mask.Paths = append(mask.Paths, "thumbnail")
+	}
+	if req.Category != nil {
+		content.Category = *req.Category
+		mask.Paths = append(mask.Paths, "category")
+	}
+	if req.Duration != nil {
+		content.Duration = durationpb.New(*req.Duration)
+		mask.Paths = append(mask.Paths, "duration")
+	}
+	if req.Resolution != nil {
+		content.Resolution = *req.Resolution
+		mask.Paths = append(mask.Paths, "resolution")
+	}
+	if req.FileSize != nil {
+		content.FileSize = *req.FileSize
+		mask.Paths = append(mask.Paths, "file_size")
+	}
+	if req.Format != nil {
+		content.Format = *req.Format
+		mask.Paths = append(mask.Paths, "format")
+	}
+	if req.Quality != nil {
+		content.Quality = *req.Quality
+		mask.Paths = append(mask.Paths, "quality")
+	}
+	if req.ApprovalStatus != nil {
+		content.ApprovalStatus = *req.ApprovalStatus
+		mask.Paths = append(mask.Paths, "approval_status")
+	}
+	return content, mask
+}
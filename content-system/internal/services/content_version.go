@@ -0,0 +1,18 @@
+package services
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// expectedVersion 优先取 If-Match 请求头中的版本号, 如 If-Match: "3", 未传时使用请求体中的值
+func expectedVersion(c *gin.Context, version int64) (int64, error) {
+	etag := strings.TrimSpace(c.GetHeader("If-Match"))
+	if etag == "" || etag == "*" {
+		return version, nil
+	}
+	etag = strings.Trim(strings.TrimPrefix(etag, "W/"), `"`)
+	return strconv.ParseInt(etag, 10, 64)
+}
@@ -0,0 +1,73 @@
+package services
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/zerokkcoder/content-system/internal/api/operate"
+)
+
+type ContentWatchReq struct {
+	Author         string  `form:"author"`
+	Category       string  `form:"category"`
+	ApprovalStatus []int32 `form:"approval_status"`
+	ResumeToken    string  `form:"resume_token"`
+}
+
+// ContentWatch 以 server-sent events 推送内容变更, 事件的 id 即 resume_token
+func (ca *CmsApp) ContentWatch(c *gin.Context) {
+	var req ContentWatchReq
+	if err := c.ShouldBindQuery(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+	// 浏览器 EventSource 重连时通过 Last-Event-ID 带回最后收到的事件 id
+	if id := c.GetHeader("Last-Event-ID"); id != "" {
+		req.ResumeToken = id
+	}
+
+	stream, err := ca.operationAppClient.WatchContent(c, &operate.WatchContentReq{
+		Author:         req.Author,
+		Category:       req.Category,
+		ApprovalStatus: req.ApprovalStatus,
+		ResumeToken:    req.ResumeToken,
+	})
+	if err != nil {
+		rpcError(c, err)
+		return
+	}
+
+	c.Header("Content-Type", "text/event-stream")
+	c.Header("Cache-Control", "no-cache")
+	c.Header("Connection", "keep-alive")
+	c.Stream(func(w io.Writer) bool {
+		rsp, err := stream.Recv()
+		if err != nil {
+			if err != io.EOF {
+				writeEvent(w, "", "error", rpcErrorBody(errors.FromError(err)))
+			}
+			return false
+		}
+		if rsp.GetHeartbeat() {
+			// 注释行作为心跳, 浏览器不会触发事件
+			fmt.Fprintf(w, ": heartbeat %s\n\n", rsp.GetResumeToken())
+			return true
+		}
+		writeEvent(w, rsp.GetResumeToken(), rsp.GetType(), rsp)
+		return true
+	})
+}
+
+func writeEvent(w io.Writer, id, event string, data interface{}) {
+	payload, _ := json.Marshal(data)
+	if id != "" {
+		fmt.Fprintf(w, "id: %s\n", id)
+	}
+	fmt.Fprintf(w, "event: %s\ndata: %s\n\n", event, payload)
+}
@@ -92,6 +92,12 @@ func (ca *CmsApp) genetateSessionID(ctx context.Context, username string) (strin
 		fmt.Printf("set redis auth error = %v\n", err)
 		return "", err
 	}
+	// 会话对应的用户, 鉴权时取出作为当前用户
+	err = ca.rdb.Set(ctx, utils.GetSessionUserKey(sessionID), username, time.Hour*8).Err()
+	if err != nil {
+		fmt.Printf("set redis session user error = %v\n", err)
+		return "", err
+	}
 	fmt.Println("sessionKey", sessionKey)
 	fmt.Println("authKey", authKey)
 	return sessionID, nil
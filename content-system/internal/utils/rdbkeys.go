@@ -11,3 +11,8 @@ func GetSessionKey(username string) string {
 	sessionKey := fmt.Sprintf("session_id:%s", username)
 	return sessionKey
 }
+
+// GetSessionUserKey 登录会话对应的用户名
+func GetSessionUserKey(sessionID string) string {
+	return fmt.Sprintf("session_user:%s", sessionID)
+}
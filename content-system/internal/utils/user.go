@@ -0,0 +1,16 @@
+package utils
+
+import "github.com/gin-gonic/gin"
+
+// userKey 鉴权通过后保存在 gin.Context 中的用户名
+const userKey = "username"
+
+// SetUser 保存当前登录的用户名, 由鉴权中间件调用
+func SetUser(c *gin.Context, username string) {
+	c.Set(userKey, username)
+}
+
+// GetUser 返回当前登录的用户名, 未经过鉴权时为空
+func GetUser(c *gin.Context) string {
+	return c.GetString(userKey)
+}
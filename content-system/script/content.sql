@@ -8,7 +8,7 @@ CREATE TABLE `t_content_details` (
     `video_url` varchar(255) DEFAULT '' COMMENT '视频链接',
     `thumbnail` varchar(255) DEFAULT '' COMMENT '封面图',
     `category` varchar(64) DEFAULT '' COMMENT '分类',
-    `duration`  int DEFAULT 0 COMMENT '时长',
+    `duration`  bigint DEFAULT 0 COMMENT '时长, 单位纳秒',
     `resolution` varchar(64) DEFAULT '' COMMENT '分辨率',
     `file_size` int DEFAULT 0 COMMENT '文件大小',
     `format` varchar(64) DEFAULT '' COMMENT '格式',